@@ -0,0 +1,49 @@
+// Command rebuild-timeline repairs the materialized home timeline of a user:
+//
+//	go run ./cmd/rebuild-timeline <user-id>
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/joho/godotenv"
+	_ "github.com/lib/pq"
+
+	"Chirpy/internal/database"
+	"Chirpy/internal/timeline"
+)
+
+func main() {
+	godotenv.Load()
+
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: rebuild-timeline <user-id>")
+		os.Exit(2)
+	}
+	userID, err := uuid.Parse(os.Args[1])
+	if err != nil {
+		log.Fatalf("Invalid user ID: %s", err)
+	}
+
+	dbURL := os.Getenv("DB_URL")
+	if dbURL == "" {
+		log.Fatal("DB_URL must be set")
+	}
+
+	db, err := sql.Open("postgres", dbURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	entries, err := timeline.Rebuild(context.Background(), db, database.New(db), userID)
+	if err != nil {
+		log.Fatalf("Error rebuilding timeline: %s", err)
+	}
+	log.Printf("Rebuilt home timeline for %s with %d entries", userID, entries)
+}
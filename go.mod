@@ -7,5 +7,8 @@ require (
 	github.com/google/uuid v1.6.0
 	github.com/joho/godotenv v1.5.1
 	github.com/lib/pq v1.10.9
+	github.com/rivo/uniseg v0.4.7
 	golang.org/x/crypto v0.40.0
+	golang.org/x/image v0.25.0
+	golang.org/x/text v0.27.0
 )
@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"Chirpy/internal/auth"
+)
+
+// requireAdmin checks the ApiKey authorization header against ADMIN_KEY and
+// writes a 401 when it doesn't match. Admin endpoints are disabled entirely
+// when no key is configured. The key is compared in constant time so response
+// timing doesn't reveal how much of a guess was right.
+func (cfg *ApiConfig) requireAdmin(w http.ResponseWriter, r *http.Request) bool {
+	apiKey, err := auth.GetAPIKey(r.Header)
+	if err != nil || cfg.AdminKey == "" || subtle.ConstantTimeCompare([]byte(apiKey), []byte(cfg.AdminKey)) != 1 {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid admin key")
+		return false
+	}
+	return true
+}
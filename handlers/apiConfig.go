@@ -1,15 +1,30 @@
 package handlers
 
 import (
+	"database/sql"
 	"sync/atomic"
+	"time"
 
+	"Chirpy/internal/blobstore"
 	"Chirpy/internal/database"
 )
 
 type ApiConfig struct {
+    Db             *sql.DB
     DbQueries      *database.Queries
+    BlobStore      blobstore.BlobStore
 	FileserverHits atomic.Int32
     Platform       string
 	JwtSecret      string
 	PolkaKey       string
+	AdminKey       string
+	ChirpRetention time.Duration
+	// Maximum chirp length for regular and Chirpy Red accounts.
+	MaxChirpLength    int
+	MaxChirpLengthRed int
+	// Chirps linking to these domains or their subdomains are rejected.
+	DeniedLinkDomains []string
+	// Chirps by accounts with more followers than this aren't fanned out to
+	// home timelines but merged in when a timeline is read.
+	FanOutFollowerLimit int64
 }
\ No newline at end of file
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+)
+
+const maxMutedKeywordChars = 100
+
+type relationshipEntry struct {
+	ID        uuid.UUID `json:"id"`
+	Handle    string    `json:"handle"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type mutedKeywordResponse struct {
+	ID        uuid.UUID  `json:"id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	Keyword   string     `json:"keyword"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+func newMutedKeywordResponse(keyword database.MutedKeyword) mutedKeywordResponse {
+	return mutedKeywordResponse{
+		ID:        keyword.ID,
+		UserID:    keyword.UserID,
+		Keyword:   keyword.Keyword,
+		CreatedAt: keyword.CreatedAt,
+		ExpiresAt: nullTimePtr(keyword.ExpiresAt),
+	}
+}
+
+// blockUser records a block and severs the follows between the two users in
+// both directions, along with each one's chirps in the other's timeline,
+// notifications and lists. q should be bound to a transaction.
+//
+// Both users' rows are locked first, as the follow and follow request
+// handlers do, so a follow racing with the block either commits before it
+// and is severed here or sees the block and is refused. Adding a user to a
+// list takes the same locks for the same reason.
+func blockUser(ctx context.Context, q *database.Queries, blockerID, blockedID uuid.UUID) error {
+	err := q.LockUsers(ctx, []uuid.UUID{blockerID, blockedID})
+	if err != nil {
+		return err
+	}
+
+	err = q.CreateBlock(ctx, database.CreateBlockParams{
+		BlockerID: blockerID,
+		BlockedID: blockedID,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, pair := range [][2]uuid.UUID{{blockerID, blockedID}, {blockedID, blockerID}} {
+		_, err = q.UnfollowUser(ctx, database.UnfollowUserParams{
+			FollowerID: pair[0],
+			FolloweeID: pair[1],
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteHomeTimelineAuthor(ctx, database.DeleteHomeTimelineAuthorParams{
+			UserID:   pair[0],
+			AuthorID: pair[1],
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = q.DeleteFollowRequest(ctx, database.DeleteFollowRequestParams{
+			RequesterID: pair[0],
+			TargetID:    pair[1],
+		})
+		if err != nil {
+			return err
+		}
+
+		// A notification left without actors is deleted with the last one.
+		err = q.DeleteNotificationActor(ctx, database.DeleteNotificationActorParams{
+			UserID:  pair[0],
+			ActorID: pair[1],
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteListMembersByOwner(ctx, database.DeleteListMembersByOwnerParams{
+			OwnerID: pair[0],
+			UserID:  pair[1],
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseMutedKeyword lowercases a keyword to mute and validates its optional
+// expiry. A missing expiry mutes the keyword until it is removed.
+func parseMutedKeyword(keyword string, expiresAt *time.Time) (string, sql.NullTime, error) {
+	keyword = strings.ToLower(strings.TrimSpace(keyword))
+	if keyword == "" {
+		return "", sql.NullTime{}, errors.New("keyword is required")
+	}
+	if utf8.RuneCountInString(keyword) > maxMutedKeywordChars {
+		return "", sql.NullTime{}, errors.New("keyword must be 100 characters or fewer")
+	}
+
+	if expiresAt == nil {
+		return keyword, sql.NullTime{}, nil
+	}
+	if !expiresAt.After(time.Now()) {
+		return "", sql.NullTime{}, errors.New("expires_at must be in the future")
+	}
+	return keyword, sql.NullTime{Time: expiresAt.UTC(), Valid: true}, nil
+}
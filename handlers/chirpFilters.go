@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+	"Chirpy/internal/pagination"
+)
+
+const (
+	chirpSortAsc        = "asc"
+	chirpSortDesc       = "desc"
+	chirpSortEngagement = "engagement"
+)
+
+type chirpFilters struct {
+	AuthorIDs     []uuid.UUID
+	CreatedAfter  sql.NullTime
+	CreatedBefore sql.NullTime
+	ReplyToID     uuid.NullUUID
+	IsReply       sql.NullBool
+	HasReplies    sql.NullBool
+	MinLikes      int32
+	Sort          string
+	Limit         int
+}
+
+// chirpFilterParams lists every query parameter the chirp listing accepts;
+// anything else is rejected so typos don't silently return unfiltered data.
+var chirpFilterParams = map[string]bool{
+	"author_id":      true,
+	"created_after":  true,
+	"created_before": true,
+	"reply_to_id":    true,
+	"type":           true,
+	"has_replies":    true,
+	"min_likes":      true,
+	"sort":           true,
+	"limit":          true,
+	"cursor":         true,
+}
+
+// parseChirpFilters validates the listing query parameters. author_id may be
+// repeated or comma separated, the time bounds are RFC 3339 timestamps, and
+// type narrows the listing to replies or to original chirps.
+func parseChirpFilters(query url.Values) (chirpFilters, error) {
+	filters := chirpFilters{AuthorIDs: []uuid.UUID{}}
+
+	for name := range query {
+		if !chirpFilterParams[name] {
+			return chirpFilters{}, fmt.Errorf("unknown query parameter: %s", name)
+		}
+	}
+
+	for _, value := range query["author_id"] {
+		for _, idString := range strings.Split(value, ",") {
+			authorID, err := uuid.Parse(strings.TrimSpace(idString))
+			if err != nil {
+				return chirpFilters{}, fmt.Errorf("invalid author_id format: %s", idString)
+			}
+			filters.AuthorIDs = append(filters.AuthorIDs, authorID)
+		}
+	}
+
+	var err error
+	filters.CreatedAfter, err = parseTimeParam(query, "created_after")
+	if err != nil {
+		return chirpFilters{}, err
+	}
+	filters.CreatedBefore, err = parseTimeParam(query, "created_before")
+	if err != nil {
+		return chirpFilters{}, err
+	}
+	if filters.CreatedAfter.Valid && filters.CreatedBefore.Valid &&
+		!filters.CreatedAfter.Time.Before(filters.CreatedBefore.Time) {
+		return chirpFilters{}, fmt.Errorf("created_after must be before created_before")
+	}
+
+	if value := query.Get("reply_to_id"); value != "" {
+		replyToID, err := uuid.Parse(value)
+		if err != nil {
+			return chirpFilters{}, fmt.Errorf("invalid reply_to_id format: %s", value)
+		}
+		filters.ReplyToID = uuid.NullUUID{UUID: replyToID, Valid: true}
+	}
+
+	switch query.Get("type") {
+	case "":
+	case "replies":
+		filters.IsReply = sql.NullBool{Bool: true, Valid: true}
+	case "originals":
+		filters.IsReply = sql.NullBool{Bool: false, Valid: true}
+	default:
+		return chirpFilters{}, fmt.Errorf("type must be replies or originals")
+	}
+
+	if value := query.Get("has_replies"); value != "" {
+		hasReplies, err := strconv.ParseBool(value)
+		if err != nil {
+			return chirpFilters{}, fmt.Errorf("has_replies must be true or false")
+		}
+		filters.HasReplies = sql.NullBool{Bool: hasReplies, Valid: true}
+	}
+
+	if value := query.Get("min_likes"); value != "" {
+		minLikes, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || minLikes < 0 {
+			return chirpFilters{}, fmt.Errorf("min_likes must be a non-negative integer")
+		}
+		filters.MinLikes = int32(minLikes)
+	}
+
+	switch query.Get("sort") {
+	case "", chirpSortAsc:
+		filters.Sort = chirpSortAsc
+	case chirpSortDesc, chirpSortEngagement:
+		filters.Sort = query.Get("sort")
+	default:
+		return chirpFilters{}, fmt.Errorf("sort must be asc, desc or engagement")
+	}
+
+	filters.Limit, err = pagination.ParseLimit(query.Get("limit"))
+	if err != nil {
+		return chirpFilters{}, err
+	}
+
+	return filters, nil
+}
+
+// includes reports whether a chirp passes the filters. Listing queries apply
+// them in SQL; this is for chirps loaded some other way, such as pins.
+func (filters chirpFilters) includes(chirp database.Chirp) bool {
+	if len(filters.AuthorIDs) > 0 && !slices.Contains(filters.AuthorIDs, chirp.UserID.UUID) {
+		return false
+	}
+	if filters.CreatedAfter.Valid && !chirp.CreatedAt.After(filters.CreatedAfter.Time) {
+		return false
+	}
+	if filters.CreatedBefore.Valid && !chirp.CreatedAt.Before(filters.CreatedBefore.Time) {
+		return false
+	}
+	if filters.ReplyToID.Valid && chirp.ReplyToID != filters.ReplyToID {
+		return false
+	}
+	if filters.IsReply.Valid && chirp.ReplyToID.Valid != filters.IsReply.Bool {
+		return false
+	}
+	if filters.HasReplies.Valid && (chirp.ReplyCount > 0) != filters.HasReplies.Bool {
+		return false
+	}
+	return chirp.LikeCount >= filters.MinLikes
+}
+
+func parseTimeParam(query url.Values, name string) (sql.NullTime, error) {
+	value := query.Get(name)
+	if value == "" {
+		return sql.NullTime{}, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return sql.NullTime{}, fmt.Errorf("%s must be an RFC 3339 timestamp", name)
+	}
+
+	return sql.NullTime{Time: parsed.UTC(), Valid: true}, nil
+}
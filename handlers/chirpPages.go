@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+	"Chirpy/internal/pagination"
+)
+
+type chirpCursor struct {
+	CreatedAt  time.Time `json:"created_at"`
+	Engagement int32     `json:"engagement"`
+	ID         uuid.UUID `json:"id"`
+}
+
+type chirpPage struct {
+	Chirps     []chirpResponse `json:"chirps"`
+	NextCursor string          `json:"next_cursor"`
+}
+
+// parseChirpCursor reads the optional cursor query parameter of a chirp
+// listing. Both values are NULL when the first page is requested.
+func parseChirpCursor(r *http.Request) (sql.NullTime, uuid.NullUUID, error) {
+	cursorParam := r.URL.Query().Get("cursor")
+	if cursorParam == "" {
+		return sql.NullTime{}, uuid.NullUUID{}, nil
+	}
+
+	var cursor chirpCursor
+	err := pagination.DecodeCursor(cursorParam, &cursor)
+	if err != nil {
+		return sql.NullTime{}, uuid.NullUUID{}, err
+	}
+
+	return sql.NullTime{Time: cursor.CreatedAt, Valid: true}, uuid.NullUUID{UUID: cursor.ID, Valid: true}, nil
+}
+
+// parseEngagementCursor reads the optional cursor query parameter of a chirp
+// listing sorted by engagement. Both values are NULL when the first page is
+// requested.
+func parseEngagementCursor(r *http.Request) (sql.NullInt32, uuid.NullUUID, error) {
+	cursorParam := r.URL.Query().Get("cursor")
+	if cursorParam == "" {
+		return sql.NullInt32{}, uuid.NullUUID{}, nil
+	}
+
+	var cursor chirpCursor
+	err := pagination.DecodeCursor(cursorParam, &cursor)
+	if err != nil {
+		return sql.NullInt32{}, uuid.NullUUID{}, err
+	}
+
+	return sql.NullInt32{Int32: cursor.Engagement, Valid: true}, uuid.NullUUID{UUID: cursor.ID, Valid: true}, nil
+}
+
+// respondWithChirpPage writes one page of chirps. The query should have been
+// asked for limit+1 rows so that the extra row signals there is a next page.
+// pinned chirps are placed ahead of the page and don't count towards limit.
+func (cfg *ApiConfig) respondWithChirpPage(w http.ResponseWriter, r *http.Request, pinned, chirps []database.Chirp, limit int) {
+	page := chirpPage{}
+
+	if len(chirps) > limit {
+		chirps = chirps[:limit]
+		last := chirps[len(chirps)-1]
+		nextCursor, err := pagination.EncodeCursor(chirpCursor{
+			CreatedAt:  last.CreatedAt,
+			Engagement: last.LikeCount + last.ReplyCount,
+			ID:         last.ID,
+		})
+		if err != nil {
+			log.Printf("Error encoding cursor: %s", err)
+			RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve chirps")
+			return
+		}
+		page.NextCursor = nextCursor
+		w.Header().Set("Link", pagination.NextLink(r.URL, nextCursor))
+	}
+
+	responses, err := cfg.buildChirpResponses(context.Background(), append(pinned, chirps...), cfg.viewerID(r))
+	if err != nil {
+		log.Printf("Error building chirp responses: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve chirps")
+		return
+	}
+	page.Chirps = responses
+
+	RespondWithJSON(w, http.StatusOK, page)
+}
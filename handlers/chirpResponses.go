@@ -0,0 +1,238 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+	"Chirpy/internal/entities"
+)
+
+type mentionEntity struct {
+	UserID  uuid.UUID `json:"user_id"`
+	Handle  string    `json:"handle"`
+	Indices [2]int    `json:"indices"`
+}
+
+type mediaEntity struct {
+	ID           uuid.UUID `json:"id"`
+	URL          string    `json:"url"`
+	ThumbnailURL string    `json:"thumbnail_url"`
+	ContentType  string    `json:"content_type"`
+	Width        int32     `json:"width"`
+	Height       int32     `json:"height"`
+}
+
+type chirpResponse struct {
+	ID             uuid.UUID       `json:"id"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+	Body           string          `json:"body"`
+	UserID         *uuid.UUID      `json:"user_id"`
+	PublishAt      *time.Time      `json:"publish_at,omitempty"`
+	Published      bool            `json:"published"`
+	DeletedAt      *time.Time      `json:"deleted_at,omitempty"`
+	DeletionReason *string         `json:"deletion_reason,omitempty"`
+	Visibility     string          `json:"visibility"`
+	ContentWarning *string         `json:"content_warning,omitempty"`
+	Sensitive      bool            `json:"sensitive"`
+	ReplyToID      *uuid.UUID      `json:"reply_to_id,omitempty"`
+	ReplyCount     int32           `json:"reply_count"`
+	LikeCount      int32           `json:"like_count"`
+	Author         *authorSummary  `json:"author"`
+	Mentions       []mentionEntity `json:"mentions"`
+	Links          []linkEntity    `json:"links"`
+	Media          []mediaEntity   `json:"media"`
+	Poll           *pollEntity     `json:"poll"`
+	Pinned         bool            `json:"pinned"`
+	Bookmarked     bool            `json:"bookmarked"`
+	Liked          bool            `json:"liked"`
+	// Collapsed tells clients to hide the body behind its content warning
+	// or a sensitive content notice until the viewer expands it.
+	Collapsed bool `json:"collapsed"`
+}
+
+// buildChirpResponses decorates chirps with their entities, loading each kind
+// of entity for the whole page in a single query. viewerID is the signed-in
+// user, if any, and decides viewer-specific fields such as poll votes and
+// bookmarks, which are never revealed to anyone else, and likes.
+func (cfg *ApiConfig) buildChirpResponses(ctx context.Context, chirps []database.Chirp, viewerID uuid.NullUUID) ([]chirpResponse, error) {
+	chirpIDs := make([]uuid.UUID, len(chirps))
+	authorIDs := []uuid.UUID{}
+	for i, chirp := range chirps {
+		chirpIDs[i] = chirp.ID
+		if chirp.UserID.Valid {
+			authorIDs = append(authorIDs, chirp.UserID.UUID)
+		}
+	}
+
+	authors, err := cfg.readAuthorSummaries(ctx, authorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	mentions, err := cfg.DbQueries.ReadMentionsByChirpIDs(ctx, chirpIDs)
+	if err != nil {
+		return nil, err
+	}
+	mentionedUsers := map[uuid.UUID]map[string]uuid.UUID{}
+	for _, mention := range mentions {
+		if mentionedUsers[mention.ChirpID] == nil {
+			mentionedUsers[mention.ChirpID] = map[string]uuid.UUID{}
+		}
+		mentionedUsers[mention.ChirpID][mention.Handle] = mention.UserID
+	}
+
+	linkCodes, err := cfg.readLinkCodes(ctx, chirpIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := cfg.DbQueries.ReadMediaByChirpIDs(ctx, chirpIDs)
+	if err != nil {
+		return nil, err
+	}
+	chirpMedia := map[uuid.UUID][]mediaEntity{}
+	for _, attachment := range attachments {
+		chirpMedia[attachment.ChirpID.UUID] = append(chirpMedia[attachment.ChirpID.UUID], cfg.mediaEntity(attachment))
+	}
+
+	polls, err := cfg.readPollEntities(ctx, chirpIDs, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	pinnedIDs, err := cfg.DbQueries.ReadPinnedChirpIDs(ctx, chirpIDs)
+	if err != nil {
+		return nil, err
+	}
+	pinned := map[uuid.UUID]bool{}
+	for _, id := range pinnedIDs {
+		pinned[id] = true
+	}
+
+	bookmarked := map[uuid.UUID]bool{}
+	liked := map[uuid.UUID]bool{}
+	if viewerID.Valid {
+		bookmarkedIDs, err := cfg.DbQueries.ReadBookmarkedChirpIDs(ctx, database.ReadBookmarkedChirpIDsParams{
+			UserID:   viewerID.UUID,
+			ChirpIds: chirpIDs,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range bookmarkedIDs {
+			bookmarked[id] = true
+		}
+
+		likedIDs, err := cfg.DbQueries.ReadLikedChirpIDs(ctx, database.ReadLikedChirpIDsParams{
+			UserID:   viewerID.UUID,
+			ChirpIds: chirpIDs,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range likedIDs {
+			liked[id] = true
+		}
+	}
+
+	preference, err := cfg.sensitiveContentPreference(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]chirpResponse, len(chirps))
+	for i, chirp := range chirps {
+		responses[i] = chirpResponse{
+			ID:             chirp.ID,
+			CreatedAt:      chirp.CreatedAt,
+			UpdatedAt:      chirp.UpdatedAt,
+			Body:           chirp.Body,
+			UserID:         nullUUIDPtr(chirp.UserID),
+			PublishAt:      nullTimePtr(chirp.PublishAt),
+			Published:      chirp.Published,
+			DeletedAt:      nullTimePtr(chirp.DeletedAt),
+			DeletionReason: nullStringPtr(chirp.DeletionReason),
+			Visibility:     chirp.Visibility,
+			ContentWarning: nullStringPtr(chirp.ContentWarning),
+			Sensitive:      chirp.Sensitive,
+			ReplyToID:      nullUUIDPtr(chirp.ReplyToID),
+			ReplyCount:     chirp.ReplyCount,
+			LikeCount:      chirp.LikeCount,
+			Author:         authors[chirp.UserID.UUID],
+			Mentions:       mentionEntities(chirp.Body, mentionedUsers[chirp.ID]),
+			Links:          linkEntities(chirp.Body, linkCodes[chirp.ID]),
+			Media:          chirpMedia[chirp.ID],
+			Poll:           polls[chirp.ID],
+			Pinned:         pinned[chirp.ID],
+			Bookmarked:     bookmarked[chirp.ID],
+			Liked:          liked[chirp.ID],
+			Collapsed:      (chirp.Sensitive || chirp.ContentWarning.Valid) && preference != sensitiveContentExpand,
+		}
+		if responses[i].Media == nil {
+			responses[i].Media = []mediaEntity{}
+		}
+	}
+
+	return responses, nil
+}
+
+func (cfg *ApiConfig) buildChirpResponse(ctx context.Context, chirp database.Chirp, viewerID uuid.NullUUID) (chirpResponse, error) {
+	responses, err := cfg.buildChirpResponses(ctx, []database.Chirp{chirp}, viewerID)
+	if err != nil {
+		return chirpResponse{}, err
+	}
+	return responses[0], nil
+}
+
+func (cfg *ApiConfig) mediaEntity(attachment database.MediaAttachment) mediaEntity {
+	return mediaEntity{
+		ID:           attachment.ID,
+		URL:          cfg.BlobStore.URL(attachment.BlobKey),
+		ThumbnailURL: cfg.BlobStore.URL(attachment.ThumbnailKey),
+		ContentType:  attachment.ContentType,
+		Width:        attachment.Width,
+		Height:       attachment.Height,
+	}
+}
+
+func mentionEntities(body string, users map[string]uuid.UUID) []mentionEntity {
+	result := []mentionEntity{}
+	for _, mention := range entities.ParseMentions(body) {
+		userID, ok := users[mention.Handle]
+		if !ok {
+			continue
+		}
+		result = append(result, mentionEntity{
+			UserID:  userID,
+			Handle:  mention.Handle,
+			Indices: [2]int{mention.Start, mention.End},
+		})
+	}
+	return result
+}
+
+func nullUUIDPtr(id uuid.NullUUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	return &id.UUID
+}
+
+func nullTimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+func nullStringPtr(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
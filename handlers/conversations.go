@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/chirptext"
+	"Chirpy/internal/database"
+)
+
+const (
+	maxConversationParticipants = 10
+	maxMessageLength            = 1000
+)
+
+var errConversationBlocked = errors.New("You can't message one or more of these users")
+
+type conversationCursor struct {
+	UpdatedAt time.Time `json:"updated_at"`
+	ID        uuid.UUID `json:"id"`
+}
+
+type conversationParticipant struct {
+	ID         uuid.UUID  `json:"id"`
+	Handle     string     `json:"handle"`
+	LastReadAt *time.Time `json:"last_read_at"`
+}
+
+type conversationResponse struct {
+	ID              uuid.UUID                 `json:"id"`
+	CreatedAt       time.Time                 `json:"created_at"`
+	UpdatedAt       time.Time                 `json:"updated_at"`
+	FilterProfanity bool                      `json:"filter_profanity"`
+	Participants    []conversationParticipant `json:"participants"`
+	UnreadCount     int64                     `json:"unread_count"`
+}
+
+type conversationPage struct {
+	Conversations []conversationResponse `json:"conversations"`
+	NextCursor    string                 `json:"next_cursor"`
+}
+
+type messageResponse struct {
+	ID             uuid.UUID   `json:"id"`
+	ConversationID uuid.UUID   `json:"conversation_id"`
+	SenderID       *uuid.UUID  `json:"sender_id"`
+	Body           string      `json:"body"`
+	CreatedAt      time.Time   `json:"created_at"`
+	ReadBy         []uuid.UUID `json:"read_by"`
+}
+
+type messagePage struct {
+	Messages   []messageResponse `json:"messages"`
+	NextCursor string            `json:"next_cursor"`
+}
+
+// readConversationParticipants loads the participants of several
+// conversations in one query, keyed by conversation.
+func (cfg *ApiConfig) readConversationParticipants(ctx context.Context, conversationIDs []uuid.UUID) (map[uuid.UUID][]conversationParticipant, error) {
+	rows, err := cfg.DbQueries.ReadConversationParticipants(ctx, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	participants := map[uuid.UUID][]conversationParticipant{}
+	for _, row := range rows {
+		participant := conversationParticipant{
+			ID:     row.ID,
+			Handle: row.Handle.String,
+		}
+		if row.LastReadAt.Valid {
+			lastReadAt := row.LastReadAt.Time
+			participant.LastReadAt = &lastReadAt
+		}
+		participants[row.ConversationID] = append(participants[row.ConversationID], participant)
+	}
+	return participants, nil
+}
+
+// readConversation loads a conversation the user takes part in, along with
+// its participants and the user's unread count. It returns sql.ErrNoRows
+// when the user isn't a participant.
+func (cfg *ApiConfig) readConversation(ctx context.Context, conversationID, userID uuid.UUID) (conversationResponse, error) {
+	row, err := cfg.DbQueries.ReadConversationByID(ctx, database.ReadConversationByIDParams{
+		UserID: userID,
+		ID:     conversationID,
+	})
+	if err != nil {
+		return conversationResponse{}, err
+	}
+
+	participants, err := cfg.readConversationParticipants(ctx, []uuid.UUID{conversationID})
+	if err != nil {
+		return conversationResponse{}, err
+	}
+
+	return newConversationResponse(row.Conversation, participants[conversationID], row.UnreadCount), nil
+}
+
+func newConversationResponse(conversation database.Conversation, participants []conversationParticipant, unreadCount int64) conversationResponse {
+	if participants == nil {
+		participants = []conversationParticipant{}
+	}
+	return conversationResponse{
+		ID:              conversation.ID,
+		CreatedAt:       conversation.CreatedAt,
+		UpdatedAt:       conversation.UpdatedAt,
+		FilterProfanity: conversation.FilterProfanity,
+		Participants:    participants,
+		UnreadCount:     unreadCount,
+	}
+}
+
+// newMessageResponse fills in the read receipts of a message: every other
+// participant whose last read time is at or after the message.
+func newMessageResponse(message database.Message, participants []conversationParticipant) messageResponse {
+	response := messageResponse{
+		ID:             message.ID,
+		ConversationID: message.ConversationID,
+		Body:           message.Body,
+		CreatedAt:      message.CreatedAt,
+		ReadBy:         []uuid.UUID{},
+	}
+	if message.SenderID.Valid {
+		senderID := message.SenderID.UUID
+		response.SenderID = &senderID
+	}
+
+	for _, participant := range participants {
+		if participant.ID == message.SenderID.UUID || participant.LastReadAt == nil {
+			continue
+		}
+		if !participant.LastReadAt.Before(message.CreatedAt) {
+			response.ReadBy = append(response.ReadBy, participant.ID)
+		}
+	}
+	return response
+}
+
+// normalizeMessageBody validates a message and, when the conversation has
+// the filter on, runs it through the same profanity filter as chirps.
+func normalizeMessageBody(body string, filterProfanity bool) (string, error) {
+	body, err := chirptext.Normalize(body)
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(body) == "" {
+		return "", errors.New("Message body is required")
+	}
+	if chirptext.Length(body) > maxMessageLength {
+		return "", fmt.Errorf("Message is too long (max %d characters)", maxMessageLength)
+	}
+	if filterProfanity {
+		body = cleanProfaneWords(body)
+	}
+	return body, nil
+}
+
+// checkConversationBlocks returns errConversationBlocked when there is a
+// block in either direction between userID and any of the other
+// participants.
+func checkConversationBlocks(ctx context.Context, q *database.Queries, userID uuid.UUID, participantIDs []uuid.UUID) error {
+	blockedIDs, err := q.ReadBlockedUserIDs(ctx, database.ReadBlockedUserIDsParams{
+		UserID:  userID,
+		UserIds: participantIDs,
+	})
+	if err != nil {
+		return err
+	}
+	if len(blockedIDs) > 0 {
+		return errConversationBlocked
+	}
+	return nil
+}
+
+func participantIDs(participants []conversationParticipant) []uuid.UUID {
+	ids := make([]uuid.UUID, len(participants))
+	for i, participant := range participants {
+		ids[i] = participant.ID
+	}
+	return ids
+}
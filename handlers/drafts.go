@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+)
+
+type draftResponse struct {
+	ID             uuid.UUID `json:"id"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+	Body           string    `json:"body"`
+	UserID         uuid.UUID `json:"user_id"`
+	Visibility     string    `json:"visibility"`
+	ContentWarning *string   `json:"content_warning,omitempty"`
+	Sensitive      bool      `json:"sensitive"`
+}
+
+func newDraftResponse(draft database.Draft) draftResponse {
+	return draftResponse{
+		ID:             draft.ID,
+		CreatedAt:      draft.CreatedAt,
+		UpdatedAt:      draft.UpdatedAt,
+		Body:           draft.Body,
+		UserID:         draft.UserID,
+		Visibility:     draft.Visibility,
+		ContentWarning: nullStringPtr(draft.ContentWarning),
+		Sensitive:      draft.Sensitive,
+	}
+}
@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+	"Chirpy/internal/notifications"
+	"Chirpy/internal/pagination"
+	"Chirpy/internal/timeline"
+)
+
+type followCursor struct {
+	FollowedAt time.Time `json:"followed_at"`
+	UserID     uuid.UUID `json:"user_id"`
+}
+
+type followEntry struct {
+	ID         uuid.UUID `json:"id"`
+	Handle     string    `json:"handle"`
+	FollowedAt time.Time `json:"followed_at"`
+}
+
+type followPage struct {
+	Users      []followEntry `json:"users"`
+	Count      int64         `json:"count"`
+	NextCursor string        `json:"next_cursor"`
+}
+
+// followRequestEntry is an account waiting for a protected user to approve
+// their follow.
+type followRequestEntry struct {
+	ID          uuid.UUID `json:"id"`
+	Handle      string    `json:"handle"`
+	RequestedAt time.Time `json:"requested_at"`
+}
+
+type followRequestPage struct {
+	Requests   []followRequestEntry `json:"requests"`
+	NextCursor string               `json:"next_cursor"`
+}
+
+// followSuggestionEntry is an account the viewer might follow, with what
+// they have in common so clients can explain the suggestion.
+type followSuggestionEntry struct {
+	User               *authorSummary `json:"user"`
+	MutualCount        int64          `json:"mutual_count"`
+	SharedHashtagCount int64          `json:"shared_hashtag_count"`
+}
+
+// followListRequest holds the parameters shared by the follower and
+// following lists.
+type followListRequest struct {
+	UserID           uuid.UUID
+	Limit            int
+	CursorFollowedAt sql.NullTime
+	CursorUserID     uuid.NullUUID
+}
+
+func parseFollowListRequest(r *http.Request) (followListRequest, error) {
+	userID, err := uuid.Parse(r.PathValue("userID"))
+	if err != nil {
+		return followListRequest{}, errors.New("Invalid user ID")
+	}
+
+	limit, err := pagination.ParseLimit(r.URL.Query().Get("limit"))
+	if err != nil {
+		return followListRequest{}, err
+	}
+
+	request := followListRequest{
+		UserID: userID,
+		Limit:  limit,
+	}
+	if cursorParam := r.URL.Query().Get("cursor"); cursorParam != "" {
+		var cursor followCursor
+		err = pagination.DecodeCursor(cursorParam, &cursor)
+		if err != nil {
+			return followListRequest{}, errors.New("Invalid cursor")
+		}
+		request.CursorFollowedAt = sql.NullTime{Time: cursor.FollowedAt, Valid: true}
+		request.CursorUserID = uuid.NullUUID{UUID: cursor.UserID, Valid: true}
+	}
+
+	return request, nil
+}
+
+// respondWithFollowPage writes one page of a follower or following list. The
+// query should have been asked for limit+1 rows so that the extra row
+// signals there is a next page. count is the total size of the list.
+func respondWithFollowPage(w http.ResponseWriter, r *http.Request, entries []followEntry, count int64, limit int) {
+	page := followPage{
+		Users: entries,
+		Count: count,
+	}
+
+	if len(entries) > limit {
+		page.Users = entries[:limit]
+		last := page.Users[len(page.Users)-1]
+		nextCursor, err := pagination.EncodeCursor(followCursor{FollowedAt: last.FollowedAt, UserID: last.ID})
+		if err != nil {
+			log.Printf("Error encoding cursor: %s", err)
+			RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve users")
+			return
+		}
+		page.NextCursor = nextCursor
+		w.Header().Set("Link", pagination.NextLink(r.URL, nextCursor))
+	}
+
+	RespondWithJSON(w, http.StatusOK, page)
+}
+
+// followUser makes followerID follow followeeID and returns how many follows
+// were created, which is 0 if they already did. Fan-out only covers chirps
+// published from now on, so the account's recent chirps are copied into the
+// follower's timeline as well.
+func followUser(ctx context.Context, q *database.Queries, followerID, followeeID uuid.UUID) (int64, error) {
+	followed, err := q.FollowUser(ctx, database.FollowUserParams{
+		FollowerID: followerID,
+		FolloweeID: followeeID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	err = q.BackfillHomeTimeline(ctx, database.BackfillHomeTimelineParams{
+		UserID:   followerID,
+		AuthorID: followeeID,
+		RowLimit: timeline.Depth,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return followed, nil
+}
+
+// approveFollowRequest turns a pending follow request into a follow and lets
+// the requester know. The request must already have been removed.
+func approveFollowRequest(ctx context.Context, q *database.Queries, requesterID, targetID uuid.UUID) error {
+	_, err := followUser(ctx, q, requesterID, targetID)
+	if err != nil {
+		return err
+	}
+
+	return q.CreateNotification(ctx, database.CreateNotificationParams{
+		UserID:  requesterID,
+		Type:    notifications.TypeFollowApproved,
+		ActorID: targetID,
+	})
+}
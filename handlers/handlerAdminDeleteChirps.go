@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// HandlerAdminDeleteChirps lets moderators remove any chirp. The tombstone
+// records that it was a moderator, not the author, who deleted it.
+func (cfg *ApiConfig) HandlerAdminDeleteChirps(w http.ResponseWriter, r *http.Request) {
+	if !cfg.requireAdmin(w, r) {
+		return
+	}
+
+	chirpID, err := uuid.Parse(r.PathValue("chirpID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid chirp ID")
+		return
+	}
+
+	deleted, err := cfg.softDeleteChirp(context.Background(), chirpID, deletionReasonModerator)
+	if err != nil {
+		log.Printf("Error deleting chirp: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to delete chirp")
+		return
+	}
+	if !deleted {
+		RespondWithError(w, http.StatusNotFound, "Chirp not found")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
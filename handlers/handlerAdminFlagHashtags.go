@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"Chirpy/internal/database"
+)
+
+// HandlerAdminFlagHashtags keeps a hashtag out of trends. Flagging an
+// already flagged hashtag updates its reason.
+func (cfg *ApiConfig) HandlerAdminFlagHashtags(w http.ResponseWriter, r *http.Request) {
+	if !cfg.requireAdmin(w, r) {
+		return
+	}
+
+	tag, err := hashtagFromPath(r)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	type parameters struct {
+		Reason string `json:"reason"`
+	}
+	params := parameters{}
+
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&params)
+	if err != nil {
+		log.Printf("Error decoding parameters: %s", err)
+		RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	reason := strings.TrimSpace(params.Reason)
+	if utf8.RuneCountInString(reason) > maxFlagReasonChars {
+		RespondWithError(w, http.StatusBadRequest, "reason must be 200 characters or fewer")
+		return
+	}
+
+	err = cfg.DbQueries.FlagHashtag(context.Background(), database.FlagHashtagParams{
+		Tag:    tag,
+		Reason: reason,
+	})
+	if err != nil {
+		log.Printf("Error flagging hashtag: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to flag hashtag")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"Chirpy/internal/database"
+)
+
+func (cfg *ApiConfig) HandlerAdminReadFlaggedHashtags(w http.ResponseWriter, r *http.Request) {
+	if !cfg.requireAdmin(w, r) {
+		return
+	}
+
+	hashtags, err := cfg.DbQueries.ReadFlaggedHashtags(context.Background())
+	if err != nil {
+		log.Printf("Error getting flagged hashtags: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve flagged hashtags")
+		return
+	}
+	if hashtags == nil {
+		hashtags = []database.FlaggedHashtag{}
+	}
+
+	RespondWithJSON(w, http.StatusOK, hashtags)
+}
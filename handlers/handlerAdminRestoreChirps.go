@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+)
+
+func (cfg *ApiConfig) HandlerAdminRestoreChirps(w http.ResponseWriter, r *http.Request) {
+	if !cfg.requireAdmin(w, r) {
+		return
+	}
+
+	chirpID, err := uuid.Parse(r.PathValue("chirpID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid chirp ID")
+		return
+	}
+
+	ctx := context.Background()
+	chirp, err := cfg.DbQueries.RestoreChirp(ctx, database.RestoreChirpParams{
+		ID:           chirpID,
+		DeletedAfter: sql.NullTime{Time: time.Now().UTC().Add(-cfg.ChirpRetention), Valid: true},
+	})
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "No deleted chirp within the retention window")
+		return
+	}
+	if err != nil {
+		log.Printf("Error restoring chirp: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to restore chirp")
+		return
+	}
+
+	response, err := cfg.buildChirpResponse(ctx, chirp, uuid.NullUUID{})
+	if err != nil {
+		log.Printf("Error building chirp response: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to restore chirp")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, response)
+}
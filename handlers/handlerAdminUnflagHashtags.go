@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+)
+
+// HandlerAdminUnflagHashtags lets a flagged hashtag trend again from the
+// next trends snapshot on.
+func (cfg *ApiConfig) HandlerAdminUnflagHashtags(w http.ResponseWriter, r *http.Request) {
+	if !cfg.requireAdmin(w, r) {
+		return
+	}
+
+	tag, err := hashtagFromPath(r)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	unflagged, err := cfg.DbQueries.UnflagHashtag(context.Background(), tag)
+	if err != nil {
+		log.Printf("Error unflagging hashtag: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to unflag hashtag")
+		return
+	}
+	if unflagged == 0 {
+		RespondWithError(w, http.StatusNotFound, "Hashtag is not flagged")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
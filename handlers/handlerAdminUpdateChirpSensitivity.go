@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+)
+
+// HandlerAdminUpdateChirpSensitivity lets moderators add or change the
+// content warning and sensitive flag on any chirp.
+func (cfg *ApiConfig) HandlerAdminUpdateChirpSensitivity(w http.ResponseWriter, r *http.Request) {
+	if !cfg.requireAdmin(w, r) {
+		return
+	}
+
+	chirpID, err := uuid.Parse(r.PathValue("chirpID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid chirp ID")
+		return
+	}
+
+	type parameters struct {
+		ContentWarning string `json:"content_warning"`
+		Sensitive      bool   `json:"sensitive"`
+	}
+	params := parameters{}
+
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&params)
+	if err != nil {
+		log.Printf("Error decoding parameters: %s", err)
+		RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	contentWarning, err := parseContentWarning(params.ContentWarning)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx := context.Background()
+	chirp, err := cfg.DbQueries.UpdateChirpSensitivity(ctx, database.UpdateChirpSensitivityParams{
+		ID:             chirpID,
+		ContentWarning: contentWarning,
+		Sensitive:      params.Sensitive,
+	})
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "Chirp not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error updating chirp sensitivity: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to update chirp")
+		return
+	}
+
+	response, err := cfg.buildChirpResponse(ctx, chirp, uuid.NullUUID{})
+	if err != nil {
+		log.Printf("Error building chirp response: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to update chirp")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, response)
+}
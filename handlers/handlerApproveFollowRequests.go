@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+// HandlerApproveFollowRequests makes the requester a follower and lets them
+// know their request was approved.
+func (cfg *ApiConfig) HandlerApproveFollowRequests(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	requesterID, err := uuid.Parse(r.PathValue("userID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	ctx := context.Background()
+	tx, err := cfg.Db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("Error starting transaction: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to approve follow request")
+		return
+	}
+	defer tx.Rollback()
+	qtx := cfg.DbQueries.WithTx(tx)
+
+	// Locked like a follow, so a block made meanwhile has either removed the
+	// request already or waits until the follow exists and severs it.
+	err = qtx.LockUsers(ctx, []uuid.UUID{requesterID, userID})
+	if err != nil {
+		log.Printf("Error locking users: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to approve follow request")
+		return
+	}
+
+	deleted, err := qtx.DeleteFollowRequest(ctx, database.DeleteFollowRequestParams{
+		RequesterID: requesterID,
+		TargetID:    userID,
+	})
+	if err != nil {
+		log.Printf("Error deleting follow request: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to approve follow request")
+		return
+	}
+	if deleted == 0 {
+		RespondWithError(w, http.StatusNotFound, "Follow request not found")
+		return
+	}
+
+	err = approveFollowRequest(ctx, qtx, requesterID, userID)
+	if err != nil {
+		log.Printf("Error approving follow request: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to approve follow request")
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Error committing follow request approval: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to approve follow request")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+)
+
+func (cfg *ApiConfig) HandlerBlockUsers(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	blockedID, err := uuid.Parse(r.PathValue("userID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+	if blockedID == userID {
+		RespondWithError(w, http.StatusBadRequest, "You can't block yourself")
+		return
+	}
+
+	ctx := context.Background()
+	_, err = cfg.DbQueries.ReadFollowCounts(ctx, blockedID)
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "User not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting user: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to block user")
+		return
+	}
+
+	tx, err := cfg.Db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("Error starting transaction: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to block user")
+		return
+	}
+	defer tx.Rollback()
+
+	err = blockUser(ctx, cfg.DbQueries.WithTx(tx), userID, blockedID)
+	if err != nil {
+		log.Printf("Error blocking user: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to block user")
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Error committing block: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to block user")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+func (cfg *ApiConfig) HandlerCancelScheduledChirp(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	chirpID, err := uuid.Parse(r.PathValue("chirpID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid chirp ID")
+		return
+	}
+
+	cancelled, err := cfg.DbQueries.CancelScheduledChirp(context.Background(), database.CancelScheduledChirpParams{
+		ID:     chirpID,
+		UserID: uuid.NullUUID{UUID: userID, Valid: true},
+	})
+	if err != nil {
+		log.Printf("Error cancelling scheduled chirp: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to cancel scheduled chirp")
+		return
+	}
+	if cancelled == 0 {
+		RespondWithError(w, http.StatusNotFound, "Scheduled chirp not found")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+func (cfg *ApiConfig) HandlerCreateBookmarks(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	chirpID, err := uuid.Parse(r.PathValue("chirpID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid chirp ID")
+		return
+	}
+
+	ctx := context.Background()
+	_, err = readVisibleChirp(ctx, cfg.DbQueries, chirpID, uuid.NullUUID{UUID: userID, Valid: true})
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "Chirp not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting chirp: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to bookmark chirp")
+		return
+	}
+
+	err = cfg.DbQueries.CreateBookmark(ctx, database.CreateBookmarkParams{
+		UserID:  userID,
+		ChirpID: chirpID,
+	})
+	if err != nil {
+		log.Printf("Error creating bookmark: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to bookmark chirp")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
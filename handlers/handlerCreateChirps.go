@@ -2,17 +2,29 @@ package handlers
 
 import (
     "context"
+    "database/sql"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
+	"slices"
 	"strings"
+    "time"
     
 	"github.com/google/uuid"
     
 	"Chirpy/internal/auth"
+    "Chirpy/internal/chirptext"
     "Chirpy/internal/database"
+    "Chirpy/internal/entities"
 )
 
+const maxScheduleAhead = 365 * 24 * time.Hour
+const maxMediaPerChirp = 4
+
+var errInvalidMedia = errors.New("Media must be uploaded by you and not already attached to a chirp")
+
 func (cfg *ApiConfig) HandlerCreateChirps(w http.ResponseWriter, r *http.Request) {
     token, err := auth.GetBearerToken(r.Header)
     if err != nil {
@@ -27,7 +39,14 @@ func (cfg *ApiConfig) HandlerCreateChirps(w http.ResponseWriter, r *http.Request
     }
 
     type parameters struct {
-        Body string `json:"body"`
+        Body           string          `json:"body"`
+        PublishAt      *time.Time      `json:"publish_at"`
+        MediaIDs       []uuid.UUID     `json:"media_ids"`
+        Poll           *pollParameters `json:"poll"`
+        Visibility     string          `json:"visibility"`
+        ContentWarning string          `json:"content_warning"`
+        Sensitive      bool            `json:"sensitive"`
+        ReplyToID      *uuid.UUID      `json:"reply_to_id"`
     }
     params := parameters{}
 
@@ -44,24 +63,299 @@ func (cfg *ApiConfig) HandlerCreateChirps(w http.ResponseWriter, r *http.Request
         return
     }
     
-    if len(params.Body) > 140 {
-        RespondWithError(w, http.StatusBadRequest, "Chirp is too long")
+    ctx := context.Background()
+    maxLength, err := cfg.chirpLengthLimit(ctx, cfg.DbQueries, userID)
+    if err != nil {
+        log.Printf("Error getting chirp length limit: %s", err)
+        RespondWithError(w, http.StatusInternalServerError, "Unable to create chirp")
+        return
+    }
+
+    body, err := normalizeChirpBody(params.Body, maxLength)
+    if err != nil {
+        RespondWithError(w, http.StatusBadRequest, err.Error())
         return
     }
 
-    cleanedBody := cleanProfaneWords(params.Body)
+    err = cfg.checkLinks(body)
+    if err != nil {
+        RespondWithError(w, http.StatusBadRequest, err.Error())
+        return
+    }
+
+    if len(params.MediaIDs) > maxMediaPerChirp {
+        RespondWithError(w, http.StatusBadRequest, "A chirp can have at most 4 media attachments")
+        return
+    }
+
+    publishAt, err := parsePublishAt(params.PublishAt)
+    if err != nil {
+        RespondWithError(w, http.StatusBadRequest, err.Error())
+        return
+    }
+
+    visibility, err := parseVisibility(params.Visibility)
+    if err != nil {
+        RespondWithError(w, http.StatusBadRequest, err.Error())
+        return
+    }
+
+    contentWarning, err := parseContentWarning(params.ContentWarning)
+    if err != nil {
+        RespondWithError(w, http.StatusBadRequest, err.Error())
+        return
+    }
+
+    if params.Poll != nil {
+        err = validatePoll(params.Poll, pollOpensAt(publishAt))
+        if err != nil {
+            RespondWithError(w, http.StatusBadRequest, err.Error())
+            return
+        }
+    }
+
+    cleanedBody := cleanProfaneWords(body)
+
+    tx, err := cfg.Db.BeginTx(ctx, nil)
+    if err != nil {
+        log.Printf("Error starting transaction: %s", err)
+        RespondWithError(w, http.StatusInternalServerError, "Unable to create chirp")
+        return
+    }
+    defer tx.Rollback()
+    qtx := cfg.DbQueries.WithTx(tx)
+
+    // A reply needs a chirp the author can see, which also rules out
+    // replying across a block.
+    var replyToID uuid.NullUUID
+    if params.ReplyToID != nil {
+        _, err = readVisibleChirp(ctx, qtx, *params.ReplyToID, uuid.NullUUID{UUID: userID, Valid: true})
+        if err == sql.ErrNoRows {
+            RespondWithError(w, http.StatusNotFound, "Chirp being replied to not found")
+            return
+        }
+        if err != nil {
+            log.Printf("Error getting chirp being replied to: %s", err)
+            RespondWithError(w, http.StatusInternalServerError, "Unable to create chirp")
+            return
+        }
+        replyToID = uuid.NullUUID{UUID: *params.ReplyToID, Valid: true}
+    }
 
 	createChirpParams := database.CreateChirpParams{
-		Body: cleanedBody,
-		UserID: uuid.NullUUID{UUID: userID, Valid: true},
+		Body:           cleanedBody,
+		UserID:         uuid.NullUUID{UUID: userID, Valid: true},
+		PublishAt:      publishAt,
+		Published:      !publishAt.Valid,
+		Visibility:     visibility,
+		ContentWarning: contentWarning,
+		Sensitive:      params.Sensitive,
+		ReplyToID:      replyToID,
 	}
 
-	chirp, err := cfg.DbQueries.CreateChirp(context.Background(), createChirpParams)
+	chirp, err := insertChirp(ctx, qtx, createChirpParams, cfg.FanOutFollowerLimit)
 	if err != nil {
+		log.Printf("Error creating chirp: %s", err)
 		RespondWithError(w, http.StatusBadRequest, "Unable to create chirp")
+		return
 	}
 
-    RespondWithJSON(w, http.StatusCreated, chirp)
+    err = attachMedia(ctx, qtx, chirp, params.MediaIDs)
+    if err == errInvalidMedia {
+        RespondWithError(w, http.StatusBadRequest, err.Error())
+        return
+    }
+    if err != nil {
+        log.Printf("Error attaching media: %s", err)
+        RespondWithError(w, http.StatusInternalServerError, "Unable to create chirp")
+        return
+    }
+
+    if params.Poll != nil {
+        err = createPoll(ctx, qtx, chirp.ID, *params.Poll)
+        if err != nil {
+            log.Printf("Error creating poll: %s", err)
+            RespondWithError(w, http.StatusInternalServerError, "Unable to create chirp")
+            return
+        }
+    }
+
+    err = tx.Commit()
+    if err != nil {
+        log.Printf("Error committing chirp: %s", err)
+        RespondWithError(w, http.StatusInternalServerError, "Unable to create chirp")
+        return
+    }
+
+    response, err := cfg.buildChirpResponse(ctx, chirp, uuid.NullUUID{UUID: userID, Valid: true})
+    if err != nil {
+        log.Printf("Error building chirp response: %s", err)
+        RespondWithError(w, http.StatusInternalServerError, "Unable to create chirp")
+        return
+    }
+
+    RespondWithJSON(w, http.StatusCreated, response)
+}
+
+// parsePublishAt validates an optional publish time. A missing value means
+// the chirp is published immediately.
+func parsePublishAt(publishAt *time.Time) (sql.NullTime, error) {
+    if publishAt == nil {
+        return sql.NullTime{}, nil
+    }
+
+    now := time.Now().UTC()
+    if !publishAt.After(now) {
+        return sql.NullTime{}, fmt.Errorf("publish_at must be in the future")
+    }
+    if publishAt.Sub(now) > maxScheduleAhead {
+        return sql.NullTime{}, fmt.Errorf("publish_at must be within a year")
+    }
+
+    return sql.NullTime{Time: publishAt.UTC(), Valid: true}, nil
+}
+
+// chirpLengthLimit returns the maximum chirp length for the user's account
+// tier.
+func (cfg *ApiConfig) chirpLengthLimit(ctx context.Context, q *database.Queries, userID uuid.UUID) (int, error) {
+    isChirpyRed, err := q.ReadChirpyRed(ctx, userID)
+    if err != nil {
+        return 0, err
+    }
+    if isChirpyRed {
+        return cfg.MaxChirpLengthRed, nil
+    }
+    return cfg.MaxChirpLength, nil
+}
+
+// normalizeChirpBody puts a chirp body into NFC and checks it against
+// maxLength, counted in grapheme clusters with URLs at a fixed weight.
+func normalizeChirpBody(body string, maxLength int) (string, error) {
+    body, err := chirptext.Normalize(body)
+    if err != nil {
+        return "", err
+    }
+    if chirptext.Length(body) > maxLength {
+        return "", fmt.Errorf("Chirp is too long (max %d characters)", maxLength)
+    }
+    return body, nil
+}
+
+// insertChirp creates a chirp along with the mentions, hashtags and links
+// found in its body. q should be bound to a transaction so a failure leaves
+// nothing half-written. A published chirp is queued for fan-out and marked
+// for the materialized timelines when its author has at most fanOutLimit
+// followers.
+func insertChirp(ctx context.Context, q *database.Queries, params database.CreateChirpParams, fanOutLimit int64) (database.Chirp, error) {
+    chirp, err := q.CreateChirp(ctx, params)
+    if err != nil {
+        return database.Chirp{}, err
+    }
+
+    err = createMentions(ctx, q, chirp)
+    if err != nil {
+        return database.Chirp{}, fmt.Errorf("failed to create mentions: %w", err)
+    }
+
+    err = createHashtags(ctx, q, chirp)
+    if err != nil {
+        return database.Chirp{}, fmt.Errorf("failed to create hashtags: %w", err)
+    }
+
+    err = createLinks(ctx, q, chirp)
+    if err != nil {
+        return database.Chirp{}, fmt.Errorf("failed to create links: %w", err)
+    }
+
+    // Scheduled chirps are queued for fan-out when they are published.
+    if chirp.Published {
+        err = q.EnqueueTimelineFanOut(ctx, database.EnqueueTimelineFanOutParams{
+            FanOutLimit: fanOutLimit,
+            ChirpID:     chirp.ID,
+        })
+        if err != nil {
+            return database.Chirp{}, fmt.Errorf("failed to queue fan-out: %w", err)
+        }
+    }
+
+    return chirp, nil
+}
+
+// attachMedia links previously uploaded media to a chirp in the given order.
+func attachMedia(ctx context.Context, q *database.Queries, chirp database.Chirp, mediaIDs []uuid.UUID) error {
+    for i, mediaID := range mediaIDs {
+        attached, err := q.AttachMediaToChirp(ctx, database.AttachMediaToChirpParams{
+            ChirpID:  uuid.NullUUID{UUID: chirp.ID, Valid: true},
+            Position: sql.NullInt32{Int32: int32(i), Valid: true},
+            ID:       mediaID,
+            UserID:   chirp.UserID.UUID,
+        })
+        if err != nil {
+            return err
+        }
+        if attached == 0 {
+            return errInvalidMedia
+        }
+    }
+
+    return nil
+}
+
+// createMentions resolves the @handles in a chirp body to users and records a
+// mention for each one, so that later handle changes don't rewrite history.
+// Users on either side of a block with the author are not mentioned.
+func createMentions(ctx context.Context, q *database.Queries, chirp database.Chirp) error {
+    handles := entities.MentionedHandles(chirp.Body)
+    if len(handles) == 0 {
+        return nil
+    }
+
+    users, err := q.ReadUsersByHandles(ctx, handles)
+    if err != nil {
+        return err
+    }
+
+    userIDs := make([]uuid.UUID, len(users))
+    for i, user := range users {
+        userIDs[i] = user.ID
+    }
+    blockedIDs, err := q.ReadBlockedUserIDs(ctx, database.ReadBlockedUserIDsParams{
+        UserID:  chirp.UserID.UUID,
+        UserIds: userIDs,
+    })
+    if err != nil {
+        return err
+    }
+
+    for _, user := range users {
+        if slices.Contains(blockedIDs, user.ID) {
+            continue
+        }
+        err = q.CreateChirpMention(ctx, database.CreateChirpMentionParams{
+            ChirpID: chirp.ID,
+            UserID:  user.ID,
+            Handle:  user.Handle.String,
+        })
+        if err != nil {
+            return err
+        }
+    }
+
+    return nil
+}
+
+func createHashtags(ctx context.Context, q *database.Queries, chirp database.Chirp) error {
+    for _, tag := range entities.HashtagsIn(chirp.Body) {
+        err := q.CreateChirpHashtag(ctx, database.CreateChirpHashtagParams{
+            ChirpID: chirp.ID,
+            Tag:     tag,
+        })
+        if err != nil {
+            return err
+        }
+    }
+
+    return nil
 }
 
 func cleanProfaneWords(body string) string {
@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+// HandlerCreateConversations starts a conversation between the caller and
+// participant_ids. Starting a one-to-one conversation that already exists
+// returns the existing one rather than a duplicate.
+func (cfg *ApiConfig) HandlerCreateConversations(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	type parameters struct {
+		ParticipantIDs  []uuid.UUID `json:"participant_ids"`
+		FilterProfanity *bool       `json:"filter_profanity"`
+	}
+	params := parameters{}
+
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&params)
+	if err != nil {
+		log.Printf("Error decoding parameters: %s", err)
+		RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	otherIDs := []uuid.UUID{}
+	for _, participantID := range params.ParticipantIDs {
+		if participantID != userID && !slices.Contains(otherIDs, participantID) {
+			otherIDs = append(otherIDs, participantID)
+		}
+	}
+	if len(otherIDs) == 0 {
+		RespondWithError(w, http.StatusBadRequest, "A conversation needs at least one other participant")
+		return
+	}
+	if len(otherIDs)+1 > maxConversationParticipants {
+		RespondWithError(w, http.StatusBadRequest, "A conversation can have at most 10 participants")
+		return
+	}
+
+	filterProfanity := true
+	if params.FilterProfanity != nil {
+		filterProfanity = *params.FilterProfanity
+	}
+
+	ctx := context.Background()
+	existingIDs, err := cfg.DbQueries.ReadExistingUserIDs(ctx, otherIDs)
+	if err != nil {
+		log.Printf("Error getting participants: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to create conversation")
+		return
+	}
+	if len(existingIDs) != len(otherIDs) {
+		RespondWithError(w, http.StatusNotFound, "User not found")
+		return
+	}
+
+	err = checkConversationBlocks(ctx, cfg.DbQueries, userID, otherIDs)
+	if err == errConversationBlocked {
+		RespondWithError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	if err != nil {
+		log.Printf("Error checking blocks: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to create conversation")
+		return
+	}
+
+	var conversation database.Conversation
+	found := false
+	if len(otherIDs) == 1 {
+		conversation, err = cfg.DbQueries.ReadDirectConversation(ctx, database.ReadDirectConversationParams{
+			UserID:      userID,
+			OtherUserID: otherIDs[0],
+		})
+		if err != nil && err != sql.ErrNoRows {
+			log.Printf("Error getting direct conversation: %s", err)
+			RespondWithError(w, http.StatusInternalServerError, "Failed to create conversation")
+			return
+		}
+		found = err == nil
+	}
+
+	created := false
+	if !found {
+		conversation, created, err = cfg.createConversation(ctx, userID, otherIDs, filterProfanity)
+		if err != nil {
+			log.Printf("Error creating conversation: %s", err)
+			RespondWithError(w, http.StatusInternalServerError, "Failed to create conversation")
+			return
+		}
+	}
+
+	response, err := cfg.readConversation(ctx, conversation.ID, userID)
+	if err != nil {
+		log.Printf("Error getting conversation: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to create conversation")
+		return
+	}
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	RespondWithJSON(w, status, response)
+}
+
+// createConversation creates a conversation and its participants. A
+// one-to-one conversation is unique per pair, so when another request has
+// just created it the existing one is returned with created set to false.
+func (cfg *ApiConfig) createConversation(ctx context.Context, userID uuid.UUID, otherIDs []uuid.UUID, filterProfanity bool) (database.Conversation, bool, error) {
+	tx, err := cfg.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return database.Conversation{}, false, err
+	}
+	defer tx.Rollback()
+	qtx := cfg.DbQueries.WithTx(tx)
+
+	createParams := database.CreateConversationParams{
+		CreatedBy:       uuid.NullUUID{UUID: userID, Valid: true},
+		FilterProfanity: filterProfanity,
+	}
+	if len(otherIDs) == 1 {
+		createParams.DirectUserA = uuid.NullUUID{UUID: userID, Valid: true}
+		createParams.DirectUserB = uuid.NullUUID{UUID: otherIDs[0], Valid: true}
+	}
+	conversation, err := qtx.CreateConversation(ctx, createParams)
+	if err != nil {
+		return database.Conversation{}, false, err
+	}
+
+	// The caller is only added here when the conversation is new.
+	joined, err := qtx.CreateConversationParticipant(ctx, database.CreateConversationParticipantParams{
+		ConversationID: conversation.ID,
+		UserID:         userID,
+	})
+	if err != nil {
+		return database.Conversation{}, false, err
+	}
+
+	for _, participantID := range otherIDs {
+		_, err = qtx.CreateConversationParticipant(ctx, database.CreateConversationParticipantParams{
+			ConversationID: conversation.ID,
+			UserID:         participantID,
+		})
+		if err != nil {
+			return database.Conversation{}, false, err
+		}
+	}
+
+	return conversation, joined > 0, tx.Commit()
+}
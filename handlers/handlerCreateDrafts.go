@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+func (cfg *ApiConfig) HandlerCreateDrafts(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	type parameters struct {
+		Body           string `json:"body"`
+		Visibility     string `json:"visibility"`
+		ContentWarning string `json:"content_warning"`
+		Sensitive      bool   `json:"sensitive"`
+	}
+	params := parameters{}
+
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&params)
+	if err != nil {
+		log.Printf("Error decoding parameters: %s", err)
+		RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	ctx := context.Background()
+	maxLength, err := cfg.chirpLengthLimit(ctx, cfg.DbQueries, userID)
+	if err != nil {
+		log.Printf("Error getting chirp length limit: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Unable to create draft")
+		return
+	}
+
+	body, err := normalizeChirpBody(params.Body, maxLength)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	visibility, err := parseVisibility(params.Visibility)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	contentWarning, err := parseContentWarning(params.ContentWarning)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	draft, err := cfg.DbQueries.CreateDraft(ctx, database.CreateDraftParams{
+		Body:           body,
+		UserID:         userID,
+		Visibility:     visibility,
+		ContentWarning: contentWarning,
+		Sensitive:      params.Sensitive,
+	})
+	if err != nil {
+		log.Printf("Error creating draft: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Unable to create draft")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusCreated, newDraftResponse(draft))
+}
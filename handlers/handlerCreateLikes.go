@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+	"Chirpy/internal/notifications"
+)
+
+func (cfg *ApiConfig) HandlerCreateLikes(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	chirpID, err := uuid.Parse(r.PathValue("chirpID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid chirp ID")
+		return
+	}
+
+	ctx := context.Background()
+	tx, err := cfg.Db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("Error starting transaction: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to like chirp")
+		return
+	}
+	defer tx.Rollback()
+	qtx := cfg.DbQueries.WithTx(tx)
+
+	chirp, err := readVisibleChirp(ctx, qtx, chirpID, uuid.NullUUID{UUID: userID, Valid: true})
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "Chirp not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting chirp: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to like chirp")
+		return
+	}
+
+	liked, err := qtx.CreateLike(ctx, database.CreateLikeParams{
+		UserID:  userID,
+		ChirpID: chirpID,
+	})
+	if err != nil {
+		log.Printf("Error creating like: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to like chirp")
+		return
+	}
+
+	// Liking a chirp again is a no-op, and liking your own chirp isn't news.
+	if liked > 0 && chirp.UserID.Valid && chirp.UserID.UUID != userID {
+		err = qtx.CreateNotification(ctx, database.CreateNotificationParams{
+			UserID:  chirp.UserID.UUID,
+			Type:    notifications.TypeLike,
+			ChirpID: uuid.NullUUID{UUID: chirpID, Valid: true},
+			ActorID: userID,
+		})
+		if err != nil {
+			log.Printf("Error creating like notification: %s", err)
+			RespondWithError(w, http.StatusInternalServerError, "Failed to like chirp")
+			return
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Error committing like: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to like chirp")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+// HandlerCreateListMembers adds user_id to one of the caller's lists. Users
+// on either side of a block with the owner can't be added.
+func (cfg *ApiConfig) HandlerCreateListMembers(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	listID, err := uuid.Parse(r.PathValue("listID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid list ID")
+		return
+	}
+
+	type parameters struct {
+		UserID uuid.UUID `json:"user_id"`
+	}
+	params := parameters{}
+
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&params)
+	if err != nil {
+		log.Printf("Error decoding parameters: %s", err)
+		RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	ctx := context.Background()
+	tx, err := cfg.Db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("Error starting transaction: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to add list member")
+		return
+	}
+	defer tx.Rollback()
+	qtx := cfg.DbQueries.WithTx(tx)
+
+	// Lock both users before checking for a block so a concurrent block
+	// can't land after its list member cleanup but before the insert;
+	// blockUser takes the same locks. Locking the list serializes adds so
+	// they can't both pass the member limit. The count is read by a later
+	// statement so it sees members added by whoever held the lock before.
+	err = qtx.LockUsers(ctx, []uuid.UUID{userID, params.UserID})
+	if err != nil {
+		log.Printf("Error locking users: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to add list member")
+		return
+	}
+
+	err = qtx.LockList(ctx, listID)
+	if err != nil {
+		log.Printf("Error locking list: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to add list member")
+		return
+	}
+
+	list, err := readOwnedList(ctx, qtx, listID, userID)
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "List not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting list: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to add list member")
+		return
+	}
+	if list.MemberCount >= maxListMembers {
+		RespondWithError(w, http.StatusBadRequest, "A list can have at most 500 members")
+		return
+	}
+
+	existingIDs, err := qtx.ReadExistingUserIDs(ctx, []uuid.UUID{params.UserID})
+	if err != nil {
+		log.Printf("Error getting user: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to add list member")
+		return
+	}
+	if len(existingIDs) == 0 {
+		RespondWithError(w, http.StatusNotFound, "User not found")
+		return
+	}
+
+	blocked, err := qtx.ReadBlockExists(ctx, database.ReadBlockExistsParams{
+		UserID:      userID,
+		OtherUserID: params.UserID,
+	})
+	if err != nil {
+		log.Printf("Error checking blocks: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to add list member")
+		return
+	}
+	if blocked {
+		RespondWithError(w, http.StatusForbidden, "You can't add this user to a list")
+		return
+	}
+
+	err = qtx.CreateListMember(ctx, database.CreateListMemberParams{
+		ListID: listID,
+		UserID: params.UserID,
+	})
+	if err != nil {
+		log.Printf("Error adding list member: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to add list member")
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Error committing list member: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to add list member")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
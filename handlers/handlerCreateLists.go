@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+func (cfg *ApiConfig) HandlerCreateLists(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	params := listParameters{}
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&params)
+	if err != nil {
+		log.Printf("Error decoding parameters: %s", err)
+		RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	err = params.validate()
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	list, err := cfg.DbQueries.CreateList(context.Background(), database.CreateListParams{
+		UserID:      userID,
+		Name:        params.Name,
+		Description: params.Description,
+		Visibility:  params.Visibility,
+	})
+	if err != nil {
+		log.Printf("Error creating list: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to create list")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusCreated, newListResponse(list, 0))
+}
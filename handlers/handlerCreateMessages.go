@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+// HandlerCreateMessages sends a message to a conversation. Sending counts as
+// reading everything before it, and is refused while the sender and another
+// participant have blocked each other.
+func (cfg *ApiConfig) HandlerCreateMessages(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	conversationID, err := uuid.Parse(r.PathValue("conversationID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid conversation ID")
+		return
+	}
+
+	type parameters struct {
+		Body string `json:"body"`
+	}
+	params := parameters{}
+
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&params)
+	if err != nil {
+		log.Printf("Error decoding parameters: %s", err)
+		RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	ctx := context.Background()
+	conversation, err := cfg.readConversation(ctx, conversationID, userID)
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "Conversation not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting conversation: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to send message")
+		return
+	}
+
+	body, err := normalizeMessageBody(params.Body, conversation.FilterProfanity)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err = checkConversationBlocks(ctx, cfg.DbQueries, userID, participantIDs(conversation.Participants))
+	if err == errConversationBlocked {
+		RespondWithError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	if err != nil {
+		log.Printf("Error checking blocks: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to send message")
+		return
+	}
+
+	tx, err := cfg.Db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("Error starting transaction: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to send message")
+		return
+	}
+	defer tx.Rollback()
+	qtx := cfg.DbQueries.WithTx(tx)
+
+	message, err := qtx.CreateMessage(ctx, database.CreateMessageParams{
+		ConversationID: conversationID,
+		SenderID:       uuid.NullUUID{UUID: userID, Valid: true},
+		Body:           body,
+	})
+	if err != nil {
+		log.Printf("Error creating message: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to send message")
+		return
+	}
+
+	err = qtx.TouchConversation(ctx, database.TouchConversationParams{
+		UpdatedAt: message.CreatedAt,
+		ID:        conversationID,
+	})
+	if err != nil {
+		log.Printf("Error updating conversation: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to send message")
+		return
+	}
+
+	err = qtx.MarkConversationRead(ctx, database.MarkConversationReadParams{
+		ReadAt:         message.CreatedAt,
+		ConversationID: conversationID,
+		UserID:         userID,
+	})
+	if err != nil {
+		log.Printf("Error marking conversation read: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to send message")
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Error committing message: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to send message")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusCreated, newMessageResponse(message, conversation.Participants))
+}
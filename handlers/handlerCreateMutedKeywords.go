@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+func (cfg *ApiConfig) HandlerCreateMutedKeywords(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	type parameters struct {
+		Keyword   string     `json:"keyword"`
+		ExpiresAt *time.Time `json:"expires_at"`
+	}
+	params := parameters{}
+
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&params)
+	if err != nil {
+		log.Printf("Error decoding parameters: %s", err)
+		RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	keyword, expiresAt, err := parseMutedKeyword(params.Keyword, params.ExpiresAt)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	mutedKeyword, err := cfg.DbQueries.CreateMutedKeyword(context.Background(), database.CreateMutedKeywordParams{
+		UserID:    userID,
+		Keyword:   keyword,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		log.Printf("Error muting keyword: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to mute keyword")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusCreated, newMutedKeywordResponse(mutedKeyword))
+}
@@ -17,6 +17,7 @@ func (cfg *ApiConfig) HandlerCreateUser(w http.ResponseWriter, r *http.Request)
     type parameters struct {
         Email string `json:"email"`
         Password string `json:"password"`
+        Handle string `json:"handle"`
     }
     params := parameters{}
     
@@ -33,17 +34,30 @@ func (cfg *ApiConfig) HandlerCreateUser(w http.ResponseWriter, r *http.Request)
     hashedPassword, err := auth.HashPassword(params.Password)
     if err != nil {
         RespondWithError(w, http.StatusBadRequest, err.Error())
+        return
+    }
+
+    ctx := context.Background()
+    handle, err := cfg.claimHandle(ctx, params.Handle, uuid.Nil)
+    if err != nil {
+        log.Printf("Error claiming handle: %s", err)
+        respondWithHandleError(w, err)
+        return
     }
 
     createUserParams := database.CreateUserParams{
         Email: email,
         HashedPassword: hashedPassword,
+        Handle: handle,
     }
 
     log.Printf("Creating user with email: %s", email)
 
-    ctx := context.Background()
     newUser, err := cfg.DbQueries.CreateUser(ctx, createUserParams)
+    if handleWriteError(err) == errHandleTaken {
+        respondWithHandleError(w, errHandleTaken)
+        return
+    }
     if err != nil {
         RespondWithError(w, http.StatusBadRequest, "Failed to create user")
         return
@@ -55,6 +69,7 @@ func (cfg *ApiConfig) HandlerCreateUser(w http.ResponseWriter, r *http.Request)
         UpdatedAt   time.Time `json:"updated_at"`
         Email       string    `json:"email"`
         IsChirpyRed bool      `json:"is_chirpy_red"`
+        Handle      string    `json:"handle"`
     }
 
     response := userResponse{
@@ -63,6 +78,7 @@ func (cfg *ApiConfig) HandlerCreateUser(w http.ResponseWriter, r *http.Request)
         UpdatedAt:   newUser.UpdatedAt,
         Email:       newUser.Email,
         IsChirpyRed: newUser.IsChirpyRed,
+        Handle:      newUser.Handle.String,
     }
 
     RespondWithJSON(w, http.StatusCreated, response)
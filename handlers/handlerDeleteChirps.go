@@ -28,9 +28,11 @@ func (cfg *ApiConfig) HandlerDeleteChirps(w http.ResponseWriter, r *http.Request
 	chirpID, err := uuid.Parse(chirpIDString)
 	if err != nil {
 		RespondWithError(w, http.StatusBadRequest, "Invalid chirp ID")
+		return
 	}
 
-	chirp, err := cfg.DbQueries.ReadChirpsByID(context.Background(), chirpID)
+	ctx := context.Background()
+	chirp, err := readVisibleChirp(ctx, cfg.DbQueries, chirpID, uuid.NullUUID{UUID: userID, Valid: true})
 	if err != nil {
 		if err == sql.ErrNoRows {
 			RespondWithError(w, http.StatusNotFound, "Chirp not found")
@@ -46,11 +48,16 @@ func (cfg *ApiConfig) HandlerDeleteChirps(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	err = cfg.DbQueries.DeleteChirps(context.Background(), chirpID)
+	deleted, err := cfg.softDeleteChirp(ctx, chirpID, deletionReasonOwner)
 	if err != nil {
 		log.Printf("Error deleting chirp: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to delete chirp")
+		return
+	}
+	if !deleted {
+		RespondWithError(w, http.StatusNotFound, "Chirp not found")
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
-}
\ No newline at end of file
+}
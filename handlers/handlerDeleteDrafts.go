@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+func (cfg *ApiConfig) HandlerDeleteDrafts(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	draftID, err := uuid.Parse(r.PathValue("draftID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid draft ID")
+		return
+	}
+
+	deleted, err := cfg.DbQueries.DeleteDraft(context.Background(), database.DeleteDraftParams{
+		ID:     draftID,
+		UserID: userID,
+	})
+	if err != nil {
+		log.Printf("Error deleting draft: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to delete draft")
+		return
+	}
+	if deleted == 0 {
+		RespondWithError(w, http.StatusNotFound, "Draft not found")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+func (cfg *ApiConfig) HandlerDeleteListMembers(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	listID, err := uuid.Parse(r.PathValue("listID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid list ID")
+		return
+	}
+
+	memberID, err := uuid.Parse(r.PathValue("userID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	ctx := context.Background()
+	_, err = readOwnedList(ctx, cfg.DbQueries, listID, userID)
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "List not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting list: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to remove list member")
+		return
+	}
+
+	removed, err := cfg.DbQueries.DeleteListMember(ctx, database.DeleteListMemberParams{
+		ListID: listID,
+		UserID: memberID,
+	})
+	if err != nil {
+		log.Printf("Error removing list member: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to remove list member")
+		return
+	}
+	if removed == 0 {
+		RespondWithError(w, http.StatusNotFound, "User is not a member of this list")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+func (cfg *ApiConfig) HandlerDeleteLists(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	listID, err := uuid.Parse(r.PathValue("listID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid list ID")
+		return
+	}
+
+	deleted, err := cfg.DbQueries.DeleteList(context.Background(), database.DeleteListParams{
+		ID:     listID,
+		UserID: userID,
+	})
+	if err != nil {
+		log.Printf("Error deleting list: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to delete list")
+		return
+	}
+	if deleted == 0 {
+		RespondWithError(w, http.StatusNotFound, "List not found")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
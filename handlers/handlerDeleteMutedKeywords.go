@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+func (cfg *ApiConfig) HandlerDeleteMutedKeywords(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	keywordID, err := uuid.Parse(r.PathValue("keywordID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid keyword ID")
+		return
+	}
+
+	deleted, err := cfg.DbQueries.DeleteMutedKeyword(context.Background(), database.DeleteMutedKeywordParams{
+		ID:     keywordID,
+		UserID: userID,
+	})
+	if err != nil {
+		log.Printf("Error deleting muted keyword: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to unmute keyword")
+		return
+	}
+	if deleted == 0 {
+		RespondWithError(w, http.StatusNotFound, "Muted keyword not found")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+// HandlerDenyFollowRequests drops a follow request. The requester isn't
+// told, and is free to ask again.
+func (cfg *ApiConfig) HandlerDenyFollowRequests(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	requesterID, err := uuid.Parse(r.PathValue("userID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	deleted, err := cfg.DbQueries.DeleteFollowRequest(context.Background(), database.DeleteFollowRequestParams{
+		RequesterID: requesterID,
+		TargetID:    userID,
+	})
+	if err != nil {
+		log.Printf("Error deleting follow request: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to deny follow request")
+		return
+	}
+	if deleted == 0 {
+		RespondWithError(w, http.StatusNotFound, "Follow request not found")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+)
+
+// HandlerFollowLink counts a click on a short link and redirects to its URL.
+// The redirect is temporary so browsers come back through here and every
+// click is counted. Links to domains added to the denylist after they were
+// shortened are gone rather than redirected.
+func (cfg *ApiConfig) HandlerFollowLink(w http.ResponseWriter, r *http.Request) {
+	ctx := context.Background()
+	code := r.PathValue("code")
+
+	url, err := cfg.DbQueries.ReadLinkURL(ctx, code)
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "Link not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting link: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to follow link")
+		return
+	}
+
+	if cfg.linkDenied(url) {
+		RespondWithError(w, http.StatusGone, "Link points to a blocked domain")
+		return
+	}
+
+	_, err = cfg.DbQueries.RecordLinkClick(ctx, code)
+	if err != nil {
+		log.Printf("Error recording link click: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to follow link")
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	http.Redirect(w, r, url, http.StatusFound)
+}
@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+	"Chirpy/internal/notifications"
+)
+
+func (cfg *ApiConfig) HandlerFollowUsers(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	followeeID, err := uuid.Parse(r.PathValue("userID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+	if followeeID == userID {
+		RespondWithError(w, http.StatusBadRequest, "You can't follow yourself")
+		return
+	}
+
+	ctx := context.Background()
+	tx, err := cfg.Db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("Error starting transaction: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to follow user")
+		return
+	}
+	defer tx.Rollback()
+	qtx := cfg.DbQueries.WithTx(tx)
+
+	// Lock both users before checking for a block so a concurrent block
+	// can't land between the check and the follow; blockUser takes the same
+	// locks.
+	err = qtx.LockUsers(ctx, []uuid.UUID{userID, followeeID})
+	if err != nil {
+		log.Printf("Error locking users: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to follow user")
+		return
+	}
+
+	target, err := qtx.ReadFollowTarget(ctx, database.ReadFollowTargetParams{
+		FollowerID: userID,
+		FolloweeID: followeeID,
+	})
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "User not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting user: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to follow user")
+		return
+	}
+
+	blocked, err := qtx.ReadBlockExists(ctx, database.ReadBlockExistsParams{
+		UserID:      userID,
+		OtherUserID: followeeID,
+	})
+	if err != nil {
+		log.Printf("Error checking blocks: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to follow user")
+		return
+	}
+	if blocked {
+		RespondWithError(w, http.StatusForbidden, "You can't follow this user")
+		return
+	}
+
+	// Protected accounts approve each follower, so leave them a request
+	// instead and answer 202 Accepted.
+	if target.Protected && !target.Following {
+		requested, err := qtx.CreateFollowRequest(ctx, database.CreateFollowRequestParams{
+			RequesterID: userID,
+			TargetID:    followeeID,
+		})
+		if err != nil {
+			log.Printf("Error creating follow request: %s", err)
+			RespondWithError(w, http.StatusInternalServerError, "Failed to follow user")
+			return
+		}
+
+		if requested > 0 {
+			err = qtx.CreateNotification(ctx, database.CreateNotificationParams{
+				UserID:  followeeID,
+				Type:    notifications.TypeFollowRequest,
+				ActorID: userID,
+			})
+			if err != nil {
+				log.Printf("Error creating follow request notification: %s", err)
+				RespondWithError(w, http.StatusInternalServerError, "Failed to follow user")
+				return
+			}
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			log.Printf("Error committing follow request: %s", err)
+			RespondWithError(w, http.StatusInternalServerError, "Failed to follow user")
+			return
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	followed, err := followUser(ctx, qtx, userID, followeeID)
+	if err != nil {
+		log.Printf("Error following user: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to follow user")
+		return
+	}
+
+	// Following someone again is a no-op and shouldn't notify them twice.
+	if followed > 0 {
+		err = qtx.CreateNotification(ctx, database.CreateNotificationParams{
+			UserID:  followeeID,
+			Type:    notifications.TypeFollow,
+			ActorID: userID,
+		})
+		if err != nil {
+			log.Printf("Error creating follow notification: %s", err)
+			RespondWithError(w, http.StatusInternalServerError, "Failed to follow user")
+			return
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Error committing follow: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to follow user")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -66,23 +66,29 @@ func (cfg *ApiConfig) HandlerLogin(w http.ResponseWriter, r *http.Request) {
     }
 
 type loginResponse struct {
-    ID           uuid.UUID `json:"id"`
-    CreatedAt    time.Time `json:"created_at"`
-    UpdatedAt    time.Time `json:"updated_at"`
-    Email        string    `json:"email"`
-    IsChirpyRed  bool      `json:"is_chirpy_red"`
-    Token        string    `json:"token"`
-    RefreshToken string    `json:"refresh_token"`
+    ID               uuid.UUID `json:"id"`
+    CreatedAt        time.Time `json:"created_at"`
+    UpdatedAt        time.Time `json:"updated_at"`
+    Email            string    `json:"email"`
+    IsChirpyRed      bool      `json:"is_chirpy_red"`
+    Handle           string    `json:"handle"`
+    SensitiveContent string    `json:"sensitive_content"`
+    Protected        bool      `json:"protected"`
+    Token            string    `json:"token"`
+    RefreshToken     string    `json:"refresh_token"`
 }
 
 response := loginResponse{
-    ID:           user.ID,
-    CreatedAt:    user.CreatedAt,
-    UpdatedAt:    user.UpdatedAt,
-    Email:        user.Email,
-    IsChirpyRed:  user.IsChirpyRed,
-    Token:        accessToken,
-    RefreshToken: refreshToken,
+    ID:               user.ID,
+    CreatedAt:        user.CreatedAt,
+    UpdatedAt:        user.UpdatedAt,
+    Email:            user.Email,
+    IsChirpyRed:      user.IsChirpyRed,
+    Handle:           user.Handle.String,
+    SensitiveContent: user.SensitiveContent,
+    Protected:        user.Protected,
+    Token:            accessToken,
+    RefreshToken:     refreshToken,
 }
 
     RespondWithJSON(w, http.StatusOK, response)
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"Chirpy/internal/auth"
+)
+
+func (cfg *ApiConfig) HandlerMarkAllNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	err = cfg.DbQueries.MarkAllNotificationsRead(context.Background(), userID)
+	if err != nil {
+		log.Printf("Error marking notifications read: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to mark notifications read")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
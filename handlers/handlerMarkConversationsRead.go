@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+// HandlerMarkConversationsRead moves the caller's read receipt forward to
+// message_id, or to the latest message when none is given. A receipt never
+// moves backwards.
+func (cfg *ApiConfig) HandlerMarkConversationsRead(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	conversationID, err := uuid.Parse(r.PathValue("conversationID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid conversation ID")
+		return
+	}
+
+	type parameters struct {
+		MessageID *uuid.UUID `json:"message_id"`
+	}
+	params := parameters{}
+
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&params)
+	if err != nil && err != io.EOF {
+		log.Printf("Error decoding parameters: %s", err)
+		RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	ctx := context.Background()
+	conversation, err := cfg.readConversation(ctx, conversationID, userID)
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "Conversation not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting conversation: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to mark conversation read")
+		return
+	}
+
+	// The conversation is touched with the time of its latest message.
+	readAt := conversation.UpdatedAt
+	if params.MessageID != nil {
+		message, err := cfg.DbQueries.ReadMessageByID(ctx, database.ReadMessageByIDParams{
+			ID:             *params.MessageID,
+			ConversationID: conversationID,
+		})
+		if err == sql.ErrNoRows {
+			RespondWithError(w, http.StatusNotFound, "Message not found")
+			return
+		}
+		if err != nil {
+			log.Printf("Error getting message: %s", err)
+			RespondWithError(w, http.StatusInternalServerError, "Failed to mark conversation read")
+			return
+		}
+		readAt = message.CreatedAt
+	}
+
+	err = cfg.DbQueries.MarkConversationRead(ctx, database.MarkConversationReadParams{
+		ReadAt:         readAt,
+		ConversationID: conversationID,
+		UserID:         userID,
+	})
+	if err != nil {
+		log.Printf("Error marking conversation read: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to mark conversation read")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
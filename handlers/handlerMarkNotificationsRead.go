@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+// HandlerMarkNotificationsRead marks one of the caller's notifications as
+// read. Later events of the same kind start a new group instead of joining
+// this one.
+func (cfg *ApiConfig) HandlerMarkNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	notificationID, err := uuid.Parse(r.PathValue("notificationID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid notification ID")
+		return
+	}
+
+	marked, err := cfg.DbQueries.MarkNotificationRead(context.Background(), database.MarkNotificationReadParams{
+		ID:     notificationID,
+		UserID: userID,
+	})
+	if err != nil {
+		log.Printf("Error marking notification read: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to mark notification read")
+		return
+	}
+	if marked == 0 {
+		RespondWithError(w, http.StatusNotFound, "Notification not found")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+func (cfg *ApiConfig) HandlerMuteUsers(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	mutedID, err := uuid.Parse(r.PathValue("userID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+	if mutedID == userID {
+		RespondWithError(w, http.StatusBadRequest, "You can't mute yourself")
+		return
+	}
+
+	ctx := context.Background()
+	_, err = cfg.DbQueries.ReadFollowCounts(ctx, mutedID)
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "User not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting user: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to mute user")
+		return
+	}
+
+	err = cfg.DbQueries.CreateMute(ctx, database.CreateMuteParams{
+		MuterID: userID,
+		MutedID: mutedID,
+	})
+	if err != nil {
+		log.Printf("Error muting user: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to mute user")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+const (
+	maxPinnedChirps    = 1
+	maxPinnedChirpsRed = 3
+)
+
+func (cfg *ApiConfig) HandlerPinChirps(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	chirpID, err := uuid.Parse(r.PathValue("chirpID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid chirp ID")
+		return
+	}
+
+	ctx := context.Background()
+	tx, err := cfg.Db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("Error starting transaction: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to pin chirp")
+		return
+	}
+	defer tx.Rollback()
+	qtx := cfg.DbQueries.WithTx(tx)
+
+	// Locking the user row serializes concurrent pins by the same user so
+	// the limit can't be exceeded by racing requests.
+	isChirpyRed, err := qtx.ReadChirpyRedForUpdate(ctx, userID)
+	if err != nil {
+		log.Printf("Error getting user: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to pin chirp")
+		return
+	}
+
+	chirp, err := readVisibleChirp(ctx, qtx, chirpID, uuid.NullUUID{UUID: userID, Valid: true})
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "Chirp not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting chirp: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to pin chirp")
+		return
+	}
+	if !chirp.UserID.Valid || chirp.UserID.UUID != userID {
+		RespondWithError(w, http.StatusForbidden, "You can only pin your own chirps")
+		return
+	}
+
+	pinned, err := qtx.ReadPinnedChirps(ctx, database.ReadPinnedChirpsParams{
+		UserID:   userID,
+		ViewerID: uuid.NullUUID{UUID: userID, Valid: true},
+	})
+	if err != nil {
+		log.Printf("Error getting pinned chirps: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to pin chirp")
+		return
+	}
+	alreadyPinned := slices.ContainsFunc(pinned, func(c database.Chirp) bool {
+		return c.ID == chirpID
+	})
+
+	limit := maxPinnedChirps
+	if isChirpyRed {
+		limit = maxPinnedChirpsRed
+	}
+	if !alreadyPinned && len(pinned) >= limit {
+		RespondWithError(w, http.StatusConflict, "Pinned chirp limit reached")
+		return
+	}
+
+	err = qtx.PinChirp(ctx, database.PinChirpParams{
+		UserID:  userID,
+		ChirpID: chirpID,
+	})
+	if err != nil {
+		log.Printf("Error pinning chirp: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to pin chirp")
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Error committing pin: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to pin chirp")
+		return
+	}
+
+	response, err := cfg.buildChirpResponse(ctx, chirp, uuid.NullUUID{UUID: userID, Valid: true})
+	if err != nil {
+		log.Printf("Error building chirp response: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to pin chirp")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+// HandlerPublishDraft turns a draft into a chirp. The draft is removed and
+// the chirp created in the same transaction, so a draft is published at
+// most once even if the request is retried concurrently.
+func (cfg *ApiConfig) HandlerPublishDraft(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	draftID, err := uuid.Parse(r.PathValue("draftID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid draft ID")
+		return
+	}
+
+	ctx := context.Background()
+	tx, err := cfg.Db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("Error starting transaction: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Unable to publish draft")
+		return
+	}
+	defer tx.Rollback()
+	qtx := cfg.DbQueries.WithTx(tx)
+
+	draft, err := qtx.TakeDraft(ctx, database.TakeDraftParams{
+		ID:     draftID,
+		UserID: userID,
+	})
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "Draft not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting draft: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Unable to publish draft")
+		return
+	}
+
+	maxLength, err := cfg.chirpLengthLimit(ctx, qtx, userID)
+	if err != nil {
+		log.Printf("Error getting chirp length limit: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Unable to publish draft")
+		return
+	}
+
+	body, err := normalizeChirpBody(draft.Body, maxLength)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err = cfg.checkLinks(body)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	chirp, err := insertChirp(ctx, qtx, database.CreateChirpParams{
+		Body:           cleanProfaneWords(body),
+		UserID:         uuid.NullUUID{UUID: userID, Valid: true},
+		Published:      true,
+		Visibility:     draft.Visibility,
+		ContentWarning: draft.ContentWarning,
+		Sensitive:      draft.Sensitive,
+	}, cfg.FanOutFollowerLimit)
+	if err != nil {
+		log.Printf("Error creating chirp from draft: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Unable to publish draft")
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Error committing published draft: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Unable to publish draft")
+		return
+	}
+
+	response, err := cfg.buildChirpResponse(ctx, chirp, uuid.NullUUID{UUID: userID, Valid: true})
+	if err != nil {
+		log.Printf("Error building chirp response: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Unable to publish draft")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusCreated, response)
+}
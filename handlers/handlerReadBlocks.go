@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"Chirpy/internal/auth"
+)
+
+func (cfg *ApiConfig) HandlerReadBlocks(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	rows, err := cfg.DbQueries.ReadBlocks(context.Background(), userID)
+	if err != nil {
+		log.Printf("Error getting blocked users: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve blocked users")
+		return
+	}
+
+	entries := make([]relationshipEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = relationshipEntry{
+			ID:        row.ID,
+			Handle:    row.Handle.String,
+			CreatedAt: row.CreatedAt,
+		}
+	}
+
+	RespondWithJSON(w, http.StatusOK, entries)
+}
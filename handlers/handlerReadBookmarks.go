@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+	"Chirpy/internal/pagination"
+)
+
+type bookmarkCursor struct {
+	BookmarkedAt time.Time `json:"bookmarked_at"`
+	ChirpID      uuid.UUID `json:"chirp_id"`
+}
+
+func (cfg *ApiConfig) HandlerReadBookmarks(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	limit, err := pagination.ParseLimit(r.URL.Query().Get("limit"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	params := database.ReadBookmarkedChirpsParams{
+		UserID:   userID,
+		RowLimit: int32(limit + 1),
+	}
+	if cursorParam := r.URL.Query().Get("cursor"); cursorParam != "" {
+		var cursor bookmarkCursor
+		err = pagination.DecodeCursor(cursorParam, &cursor)
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, "Invalid cursor")
+			return
+		}
+		params.CursorBookmarkedAt = sql.NullTime{Time: cursor.BookmarkedAt, Valid: true}
+		params.CursorChirpID = uuid.NullUUID{UUID: cursor.ChirpID, Valid: true}
+	}
+
+	ctx := context.Background()
+	rows, err := cfg.DbQueries.ReadBookmarkedChirps(ctx, params)
+	if err != nil {
+		log.Printf("Error getting bookmarks: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve bookmarks")
+		return
+	}
+
+	page := chirpPage{}
+	if len(rows) > limit {
+		rows = rows[:limit]
+		last := rows[len(rows)-1]
+		page.NextCursor, err = pagination.EncodeCursor(bookmarkCursor{BookmarkedAt: last.BookmarkedAt, ChirpID: last.ID})
+		if err != nil {
+			log.Printf("Error encoding cursor: %s", err)
+			RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve bookmarks")
+			return
+		}
+		w.Header().Set("Link", pagination.NextLink(r.URL, page.NextCursor))
+	}
+
+	chirps := make([]database.Chirp, len(rows))
+	for i, row := range rows {
+		chirps[i] = database.Chirp{
+			ID:             row.ID,
+			CreatedAt:      row.CreatedAt,
+			UpdatedAt:      row.UpdatedAt,
+			Body:           row.Body,
+			UserID:         row.UserID,
+			PublishAt:      row.PublishAt,
+			Published:      row.Published,
+			DeletedAt:      row.DeletedAt,
+			DeletionReason: row.DeletionReason,
+			Visibility:     row.Visibility,
+			ContentWarning: row.ContentWarning,
+			Sensitive:      row.Sensitive,
+			ReplyToID:      row.ReplyToID,
+			ReplyCount:     row.ReplyCount,
+			LikeCount:      row.LikeCount,
+		}
+	}
+
+	page.Chirps, err = cfg.buildChirpResponses(ctx, chirps, uuid.NullUUID{UUID: userID, Valid: true})
+	if err != nil {
+		log.Printf("Error building chirp responses: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve bookmarks")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, page)
+}
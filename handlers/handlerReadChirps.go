@@ -2,52 +2,121 @@ package handlers
 
 import (
     "context"
+    "database/sql"
     "log"
     "net/http"
+    "slices"
 
     "github.com/google/uuid"
+
     "Chirpy/internal/database"
 )
 
 func (cfg *ApiConfig) HandlerReadChirps(w http.ResponseWriter, r *http.Request) {
-    authorIDParam := r.URL.Query().Get("author_id")
-    sortParam := r.URL.Query().Get("sort")
-    sortDesc := (sortParam == "desc")
-    
+    filters, err := parseChirpFilters(r.URL.Query())
+    if err != nil {
+        RespondWithError(w, http.StatusBadRequest, err.Error())
+        return
+    }
+
+    ctx := context.Background()
+    viewerID := cfg.viewerID(r)
+    preference, err := cfg.sensitiveContentPreference(ctx, viewerID)
+    if err != nil {
+        log.Printf("Error getting sensitive content preference: %s", err)
+        RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve chirps")
+        return
+    }
+    excludeSensitive := preference == sensitiveContentHide
+
+    // A listing of a single author's chirps leads with their pinned chirps
+    // on the first page, and leaves them out of the chronological pages so
+    // they aren't shown twice.
+    var pinned []database.Chirp
+    if len(filters.AuthorIDs) == 1 {
+        pinned, err = cfg.DbQueries.ReadPinnedChirps(ctx, database.ReadPinnedChirpsParams{
+            UserID:   filters.AuthorIDs[0],
+            ViewerID: viewerID,
+        })
+        if err != nil {
+            log.Printf("Error getting pinned chirps: %s", err)
+            RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve chirps")
+            return
+        }
+    }
+    excludeIDs := make([]uuid.UUID, len(pinned))
+    for i, chirp := range pinned {
+        excludeIDs[i] = chirp.ID
+    }
+    if r.URL.Query().Get("cursor") != "" {
+        pinned = nil
+    }
+    pinned = slices.DeleteFunc(pinned, func(chirp database.Chirp) bool {
+        return (excludeSensitive && chirp.Sensitive) || !filters.includes(chirp)
+    })
+
     var chirps []database.Chirp
-    var err error
-    
-    if authorIDParam == "" {
-        if sortDesc {
-            chirps, err = cfg.DbQueries.ReadChirpsDesc(context.Background())
-        } else {
-            chirps, err = cfg.DbQueries.ReadChirps(context.Background())
+    if filters.Sort == chirpSortEngagement {
+        var cursorEngagement sql.NullInt32
+        var cursorID uuid.NullUUID
+        cursorEngagement, cursorID, err = parseEngagementCursor(r)
+        if err != nil {
+            RespondWithError(w, http.StatusBadRequest, "Invalid cursor")
+            return
         }
+        chirps, err = cfg.DbQueries.ListChirpsByEngagement(ctx, database.ListChirpsByEngagementParams{
+            ViewerID:         viewerID,
+            AuthorIds:        filters.AuthorIDs,
+            CreatedAfter:     filters.CreatedAfter,
+            CreatedBefore:    filters.CreatedBefore,
+            CursorEngagement: cursorEngagement,
+            CursorID:         cursorID,
+            ExcludeIds:       excludeIDs,
+            ExcludeSensitive: excludeSensitive,
+            ReplyToID:        filters.ReplyToID,
+            IsReply:          filters.IsReply,
+            HasReplies:       filters.HasReplies,
+            MinLikes:         filters.MinLikes,
+            RowLimit:         int32(filters.Limit + 1),
+        })
     } else {
-        authorID, parseErr := uuid.Parse(authorIDParam)
-        if parseErr != nil {
-            log.Printf("Invalid author_id format: %s", parseErr)
-            RespondWithError(w, http.StatusBadRequest, "Invalid author_id format")
+        var cursorCreatedAt sql.NullTime
+        var cursorID uuid.NullUUID
+        cursorCreatedAt, cursorID, err = parseChirpCursor(r)
+        if err != nil {
+            RespondWithError(w, http.StatusBadRequest, "Invalid cursor")
             return
         }
-        
-        authorUUID := uuid.NullUUID{
-            UUID:  authorID,
-            Valid: true,
+        listParams := database.ListChirpsAscParams{
+            ViewerID:         viewerID,
+            AuthorIds:        filters.AuthorIDs,
+            CreatedAfter:     filters.CreatedAfter,
+            CreatedBefore:    filters.CreatedBefore,
+            CursorCreatedAt:  cursorCreatedAt,
+            CursorID:         cursorID,
+            ExcludeIds:       excludeIDs,
+            ExcludeSensitive: excludeSensitive,
+            ReplyToID:        filters.ReplyToID,
+            IsReply:          filters.IsReply,
+            HasReplies:       filters.HasReplies,
+            MinLikes:         filters.MinLikes,
+            RowLimit:         int32(filters.Limit + 1),
         }
-        
-        if sortDesc {
-            chirps, err = cfg.DbQueries.ReadChirpsByAuthorDesc(context.Background(), authorUUID)
+
+        // Each direction has its own query so the cursor comparison and
+        // ORDER BY can walk chirps_created_at_id_idx instead of sorting every
+        // match.
+        if filters.Sort == chirpSortDesc {
+            chirps, err = cfg.DbQueries.ListChirpsDesc(ctx, database.ListChirpsDescParams(listParams))
         } else {
-            chirps, err = cfg.DbQueries.ReadChirpsByAuthor(context.Background(), authorUUID)
+            chirps, err = cfg.DbQueries.ListChirpsAsc(ctx, listParams)
         }
     }
-    
     if err != nil {
         log.Printf("Error getting chirps: %s", err)
         RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve chirps")
         return
     }
-    
-    RespondWithJSON(w, http.StatusOK, chirps)
+
+    cfg.respondWithChirpPage(w, r, pinned, chirps, filters.Limit)
 }
\ No newline at end of file
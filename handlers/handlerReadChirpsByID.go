@@ -2,9 +2,12 @@ package handlers
 
 import (
 	"context"
+	"log"
 	"net/http"
 
 	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
 )
 
 func (cfg *ApiConfig) HandlerReadChirpsByID(w http.ResponseWriter, r *http.Request) {
@@ -12,10 +15,36 @@ func (cfg *ApiConfig) HandlerReadChirpsByID(w http.ResponseWriter, r *http.Reque
 	chirpID, err := uuid.Parse(chirpIDString)
 	if err != nil {
 		RespondWithError(w, http.StatusBadRequest, "Unable to get chirp by id")
+		return
 	}
-	chirpsByID, err := cfg.DbQueries.ReadChirpsByID(context.Background(), chirpID)
+	viewerID := cfg.viewerID(r)
+	chirpsByID, err := cfg.DbQueries.ReadChirpsByID(context.Background(), database.ReadChirpsByIDParams{
+		ViewerID: viewerID,
+		ID:       chirpID,
+	})
 	if err != nil {
 		RespondWithError(w, http.StatusNotFound, "Unable to get chirp by id")
+		return
+	}
+	chirp := chirpsByID.Chirp
+	if !chirpsByID.Visible {
+		// Only viewers who could have seen the chirp learn that it was deleted.
+		if chirp.Published && chirp.DeletedAt.Valid && chirpsByID.InAudience {
+			RespondWithJSON(w, http.StatusGone, chirpTombstone{
+				ID:             chirp.ID,
+				DeletedAt:      chirp.DeletedAt.Time,
+				DeletionReason: chirp.DeletionReason.String,
+			})
+			return
+		}
+		RespondWithError(w, http.StatusNotFound, "Unable to get chirp by id")
+		return
+	}
+	response, err := cfg.buildChirpResponse(context.Background(), chirp, viewerID)
+	if err != nil {
+		log.Printf("Error building chirp response: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Unable to get chirp by id")
+		return
 	}
-	RespondWithJSON(w, http.StatusOK, chirpsByID)
-}
\ No newline at end of file
+	RespondWithJSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+	"Chirpy/internal/pagination"
+)
+
+// HandlerReadConversations lists the caller's conversations, the one with
+// the most recent message first.
+func (cfg *ApiConfig) HandlerReadConversations(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	limit, err := pagination.ParseLimit(r.URL.Query().Get("limit"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	params := database.ReadConversationsParams{
+		UserID:   userID,
+		RowLimit: int32(limit + 1),
+	}
+	if cursorParam := r.URL.Query().Get("cursor"); cursorParam != "" {
+		var cursor conversationCursor
+		err = pagination.DecodeCursor(cursorParam, &cursor)
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, "Invalid cursor")
+			return
+		}
+		params.CursorUpdatedAt = sql.NullTime{Time: cursor.UpdatedAt, Valid: true}
+		params.CursorID = uuid.NullUUID{UUID: cursor.ID, Valid: true}
+	}
+
+	ctx := context.Background()
+	rows, err := cfg.DbQueries.ReadConversations(ctx, params)
+	if err != nil {
+		log.Printf("Error getting conversations: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve conversations")
+		return
+	}
+
+	conversationIDs := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		conversationIDs[i] = row.Conversation.ID
+	}
+	participants, err := cfg.readConversationParticipants(ctx, conversationIDs)
+	if err != nil {
+		log.Printf("Error getting participants: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve conversations")
+		return
+	}
+
+	page := conversationPage{Conversations: make([]conversationResponse, 0, len(rows))}
+	for _, row := range rows {
+		page.Conversations = append(page.Conversations, newConversationResponse(row.Conversation, participants[row.Conversation.ID], row.UnreadCount))
+	}
+
+	if len(page.Conversations) > limit {
+		page.Conversations = page.Conversations[:limit]
+		last := page.Conversations[len(page.Conversations)-1]
+		nextCursor, err := pagination.EncodeCursor(conversationCursor{UpdatedAt: last.UpdatedAt, ID: last.ID})
+		if err != nil {
+			log.Printf("Error encoding cursor: %s", err)
+			RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve conversations")
+			return
+		}
+		page.NextCursor = nextCursor
+		w.Header().Set("Link", pagination.NextLink(r.URL, nextCursor))
+	}
+
+	RespondWithJSON(w, http.StatusOK, page)
+}
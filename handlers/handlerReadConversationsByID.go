@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+)
+
+func (cfg *ApiConfig) HandlerReadConversationsByID(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	conversationID, err := uuid.Parse(r.PathValue("conversationID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid conversation ID")
+		return
+	}
+
+	response, err := cfg.readConversation(context.Background(), conversationID, userID)
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "Conversation not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting conversation: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve conversation")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"Chirpy/internal/auth"
+)
+
+func (cfg *ApiConfig) HandlerReadDrafts(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	drafts, err := cfg.DbQueries.ReadDraftsByUser(context.Background(), userID)
+	if err != nil {
+		log.Printf("Error getting drafts: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve drafts")
+		return
+	}
+	response := make([]draftResponse, len(drafts))
+	for i, draft := range drafts {
+		response[i] = newDraftResponse(draft)
+	}
+
+	RespondWithJSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+func (cfg *ApiConfig) HandlerReadDraftsByID(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	draftID, err := uuid.Parse(r.PathValue("draftID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid draft ID")
+		return
+	}
+
+	draft, err := cfg.DbQueries.ReadDraftByID(context.Background(), database.ReadDraftByIDParams{
+		ID:     draftID,
+		UserID: userID,
+	})
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "Draft not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting draft: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve draft")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, newDraftResponse(draft))
+}
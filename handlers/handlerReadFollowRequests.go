@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+	"Chirpy/internal/pagination"
+)
+
+// HandlerReadFollowRequests lists the accounts waiting for the user to
+// approve their follow, most recent first.
+func (cfg *ApiConfig) HandlerReadFollowRequests(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	limit, err := pagination.ParseLimit(r.URL.Query().Get("limit"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	params := database.ReadFollowRequestsParams{
+		UserID:   userID,
+		RowLimit: int32(limit + 1),
+	}
+	if cursorParam := r.URL.Query().Get("cursor"); cursorParam != "" {
+		var cursor followCursor
+		err = pagination.DecodeCursor(cursorParam, &cursor)
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, "Invalid cursor")
+			return
+		}
+		params.CursorRequestedAt = sql.NullTime{Time: cursor.FollowedAt, Valid: true}
+		params.CursorUserID = uuid.NullUUID{UUID: cursor.UserID, Valid: true}
+	}
+
+	rows, err := cfg.DbQueries.ReadFollowRequests(context.Background(), params)
+	if err != nil {
+		log.Printf("Error getting follow requests: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve follow requests")
+		return
+	}
+
+	page := followRequestPage{
+		Requests: make([]followRequestEntry, len(rows)),
+	}
+	for i, row := range rows {
+		page.Requests[i] = followRequestEntry{
+			ID:          row.ID,
+			Handle:      row.Handle.String,
+			RequestedAt: row.RequestedAt,
+		}
+	}
+
+	if len(page.Requests) > limit {
+		page.Requests = page.Requests[:limit]
+		last := page.Requests[len(page.Requests)-1]
+		nextCursor, err := pagination.EncodeCursor(followCursor{FollowedAt: last.RequestedAt, UserID: last.ID})
+		if err != nil {
+			log.Printf("Error encoding cursor: %s", err)
+			RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve follow requests")
+			return
+		}
+		page.NextCursor = nextCursor
+		w.Header().Set("Link", pagination.NextLink(r.URL, nextCursor))
+	}
+
+	RespondWithJSON(w, http.StatusOK, page)
+}
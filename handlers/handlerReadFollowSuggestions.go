@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+	"Chirpy/internal/pagination"
+)
+
+// HandlerReadFollowSuggestions lists accounts the user might follow, best
+// first. Suggestions are computed in the background, so anyone the user
+// has followed, blocked or muted since is skipped here instead.
+func (cfg *ApiConfig) HandlerReadFollowSuggestions(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	limit, err := pagination.ParseLimit(r.URL.Query().Get("limit"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx := context.Background()
+	suggestions, err := cfg.DbQueries.ReadFollowSuggestions(ctx, database.ReadFollowSuggestionsParams{
+		UserID:   userID,
+		RowLimit: int32(limit),
+	})
+	if err != nil {
+		log.Printf("Error getting follow suggestions: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve suggestions")
+		return
+	}
+
+	suggestedIDs := make([]uuid.UUID, len(suggestions))
+	for i, suggestion := range suggestions {
+		suggestedIDs[i] = suggestion.SuggestedID
+	}
+	users, err := cfg.readAuthorSummaries(ctx, suggestedIDs)
+	if err != nil {
+		log.Printf("Error getting suggested users: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve suggestions")
+		return
+	}
+
+	entries := []followSuggestionEntry{}
+	for _, suggestion := range suggestions {
+		user, ok := users[suggestion.SuggestedID]
+		if !ok {
+			continue
+		}
+		entries = append(entries, followSuggestionEntry{
+			User:               user,
+			MutualCount:        suggestion.MutualCount,
+			SharedHashtagCount: suggestion.SharedHashtagCount,
+		})
+	}
+
+	RespondWithJSON(w, http.StatusOK, entries)
+}
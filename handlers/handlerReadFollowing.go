@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+
+	"Chirpy/internal/database"
+)
+
+func (cfg *ApiConfig) HandlerReadFollowing(w http.ResponseWriter, r *http.Request) {
+	request, err := parseFollowListRequest(r)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx := context.Background()
+	counts, err := cfg.DbQueries.ReadFollowCounts(ctx, request.UserID)
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "User not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting follow counts: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve following")
+		return
+	}
+
+	rows, err := cfg.DbQueries.ReadFollowing(ctx, database.ReadFollowingParams{
+		UserID:           request.UserID,
+		CursorFollowedAt: request.CursorFollowedAt,
+		CursorUserID:     request.CursorUserID,
+		RowLimit:         int32(request.Limit + 1),
+	})
+	if err != nil {
+		log.Printf("Error getting following: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve following")
+		return
+	}
+
+	entries := make([]followEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = followEntry{
+			ID:         row.ID,
+			Handle:     row.Handle.String,
+			FollowedAt: row.FollowedAt,
+		}
+	}
+
+	respondWithFollowPage(w, r, entries, counts.Following, request.Limit)
+}
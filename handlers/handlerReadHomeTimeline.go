@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+	"Chirpy/internal/pagination"
+	"Chirpy/internal/timeline"
+)
+
+// HandlerReadHomeTimeline lists chirps from the accounts the caller follows,
+// along with their own, newest first. Most of them come from the caller's
+// materialized timeline; the caller's own chirps and those of accounts that
+// were too big to fan out when they chirped are read separately and merged in.
+func (cfg *ApiConfig) HandlerReadHomeTimeline(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	limit, err := pagination.ParseLimit(r.URL.Query().Get("limit"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cursorCreatedAt, cursorID, err := parseChirpCursor(r)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid cursor")
+		return
+	}
+
+	ctx := context.Background()
+	preference, err := cfg.sensitiveContentPreference(ctx, uuid.NullUUID{UUID: userID, Valid: true})
+	if err != nil {
+		log.Printf("Error getting sensitive content preference: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve timeline")
+		return
+	}
+
+	entries, err := cfg.DbQueries.ReadHomeTimelineEntries(ctx, database.ReadHomeTimelineEntriesParams{
+		ViewerID:         userID,
+		CursorCreatedAt:  cursorCreatedAt,
+		CursorID:         cursorID,
+		ExcludeSensitive: preference == sensitiveContentHide,
+		RowLimit:         int32(limit + 1),
+	})
+	if err != nil {
+		log.Printf("Error getting home timeline entries: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve timeline")
+		return
+	}
+
+	fanIn, err := cfg.DbQueries.ReadHomeTimelineFanIn(ctx, database.ReadHomeTimelineFanInParams{
+		ViewerID:         userID,
+		CursorCreatedAt:  cursorCreatedAt,
+		CursorID:         cursorID,
+		ExcludeSensitive: preference == sensitiveContentHide,
+		RowLimit:         int32(limit + 1),
+	})
+	if err != nil {
+		log.Printf("Error getting home timeline fan-in chirps: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve timeline")
+		return
+	}
+
+	chirps := timeline.Merge(limit+1, entries, fanIn)
+
+	cfg.respondWithChirpPage(w, r, nil, chirps, limit)
+}
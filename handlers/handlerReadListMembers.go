@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+)
+
+func (cfg *ApiConfig) HandlerReadListMembers(w http.ResponseWriter, r *http.Request) {
+	listID, err := uuid.Parse(r.PathValue("listID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid list ID")
+		return
+	}
+
+	ctx := context.Background()
+	_, err = cfg.DbQueries.ReadListByID(ctx, database.ReadListByIDParams{
+		ID:       listID,
+		ViewerID: cfg.viewerID(r),
+	})
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "List not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting list: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve list members")
+		return
+	}
+
+	rows, err := cfg.DbQueries.ReadListMembers(ctx, listID)
+	if err != nil {
+		log.Printf("Error getting list members: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve list members")
+		return
+	}
+
+	entries := make([]relationshipEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = relationshipEntry{
+			ID:        row.ID,
+			Handle:    row.Handle.String,
+			CreatedAt: row.CreatedAt,
+		}
+	}
+
+	RespondWithJSON(w, http.StatusOK, entries)
+}
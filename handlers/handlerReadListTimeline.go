@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+	"Chirpy/internal/pagination"
+)
+
+// HandlerReadListTimeline lists chirps from a list's members, newest first.
+// Each chirp goes through the same visibility rules as the public chirp
+// listing, so a list never shows the viewer anything they couldn't find
+// there.
+func (cfg *ApiConfig) HandlerReadListTimeline(w http.ResponseWriter, r *http.Request) {
+	listID, err := uuid.Parse(r.PathValue("listID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid list ID")
+		return
+	}
+
+	limit, err := pagination.ParseLimit(r.URL.Query().Get("limit"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cursorCreatedAt, cursorID, err := parseChirpCursor(r)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid cursor")
+		return
+	}
+
+	ctx := context.Background()
+	viewerID := cfg.viewerID(r)
+	_, err = cfg.DbQueries.ReadListByID(ctx, database.ReadListByIDParams{
+		ID:       listID,
+		ViewerID: viewerID,
+	})
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "List not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting list: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve timeline")
+		return
+	}
+
+	preference, err := cfg.sensitiveContentPreference(ctx, viewerID)
+	if err != nil {
+		log.Printf("Error getting sensitive content preference: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve timeline")
+		return
+	}
+
+	chirps, err := cfg.DbQueries.ReadListTimeline(ctx, database.ReadListTimelineParams{
+		ListID:           listID,
+		ViewerID:         viewerID,
+		CursorCreatedAt:  cursorCreatedAt,
+		CursorID:         cursorID,
+		ExcludeSensitive: preference == sensitiveContentHide,
+		RowLimit:         int32(limit + 1),
+	})
+	if err != nil {
+		log.Printf("Error getting list timeline: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve timeline")
+		return
+	}
+
+	cfg.respondWithChirpPage(w, r, nil, chirps, limit)
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"Chirpy/internal/auth"
+)
+
+// HandlerReadLists lists the caller's own lists, public and private, newest
+// first.
+func (cfg *ApiConfig) HandlerReadLists(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	rows, err := cfg.DbQueries.ReadListsByUser(context.Background(), userID)
+	if err != nil {
+		log.Printf("Error getting lists: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve lists")
+		return
+	}
+
+	lists := make([]listResponse, len(rows))
+	for i, row := range rows {
+		lists[i] = newListResponse(row.List, row.MemberCount)
+	}
+
+	RespondWithJSON(w, http.StatusOK, lists)
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+)
+
+// HandlerReadListsByID returns a public list, or a private one to its owner.
+// Lists of a user on the other side of a block are reported as not found.
+func (cfg *ApiConfig) HandlerReadListsByID(w http.ResponseWriter, r *http.Request) {
+	listID, err := uuid.Parse(r.PathValue("listID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid list ID")
+		return
+	}
+
+	row, err := cfg.DbQueries.ReadListByID(context.Background(), database.ReadListByIDParams{
+		ID:       listID,
+		ViewerID: cfg.viewerID(r),
+	})
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "List not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting list: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve list")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, newListResponse(row.List, row.MemberCount))
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"path"
+
+	"Chirpy/internal/blobstore"
+	"Chirpy/internal/database"
+)
+
+// HandlerReadMediaBlob serves blobs for stores that don't have their own
+// public URL, such as the local filesystem store. Media attached to a chirp
+// is only served to viewers who can see the chirp, avatars to everyone and
+// uploads that aren't attached yet only to their owner.
+func (cfg *ApiConfig) HandlerReadMediaBlob(w http.ResponseWriter, r *http.Request) {
+	key := r.PathValue("key")
+
+	ctx := context.Background()
+	visible, err := cfg.DbQueries.ReadMediaBlobAccess(ctx, database.ReadMediaBlobAccessParams{
+		ViewerID: cfg.viewerID(r),
+		BlobKey:  key,
+	})
+	if err == sql.ErrNoRows || (err == nil && !visible) {
+		RespondWithError(w, http.StatusNotFound, "Media not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error reading media access: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve media")
+		return
+	}
+
+	blob, err := cfg.BlobStore.Get(ctx, key)
+	if err == blobstore.ErrNotFound {
+		RespondWithError(w, http.StatusNotFound, "Media not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting media: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve media")
+		return
+	}
+	defer blob.Close()
+
+	w.Header().Set("Content-Type", mime.TypeByExtension(path.Ext(key)))
+	// Whether the viewer may see a blob changes when its chirp is deleted, its
+	// author goes protected or a block is created, so every request has to
+	// come back here to be checked again.
+	w.Header().Set("Cache-Control", "private, no-cache")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	_, err = io.Copy(w, blob)
+	if err != nil {
+		log.Printf("Error serving media: %s", err)
+	}
+}
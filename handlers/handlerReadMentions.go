@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+)
+
+func (cfg *ApiConfig) HandlerReadMentions(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	ctx := context.Background()
+	chirps, err := cfg.DbQueries.ReadChirpsMentioningUser(ctx, userID)
+	if err != nil {
+		log.Printf("Error getting mentions: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve mentions")
+		return
+	}
+
+	response, err := cfg.buildChirpResponses(ctx, chirps, uuid.NullUUID{UUID: userID, Valid: true})
+	if err != nil {
+		log.Printf("Error building chirp responses: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve mentions")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, response)
+}
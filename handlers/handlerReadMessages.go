@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+	"Chirpy/internal/pagination"
+)
+
+// HandlerReadMessages lists a conversation's messages, newest first, with
+// the participants who have read each one.
+func (cfg *ApiConfig) HandlerReadMessages(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	conversationID, err := uuid.Parse(r.PathValue("conversationID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid conversation ID")
+		return
+	}
+
+	limit, err := pagination.ParseLimit(r.URL.Query().Get("limit"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cursorCreatedAt, cursorID, err := parseChirpCursor(r)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid cursor")
+		return
+	}
+
+	ctx := context.Background()
+	conversation, err := cfg.readConversation(ctx, conversationID, userID)
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "Conversation not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting conversation: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve messages")
+		return
+	}
+
+	messages, err := cfg.DbQueries.ReadMessages(ctx, database.ReadMessagesParams{
+		ConversationID:  conversationID,
+		CursorCreatedAt: cursorCreatedAt,
+		CursorID:        cursorID,
+		RowLimit:        int32(limit + 1),
+	})
+	if err != nil {
+		log.Printf("Error getting messages: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve messages")
+		return
+	}
+
+	page := messagePage{Messages: make([]messageResponse, 0, len(messages))}
+	for _, message := range messages {
+		page.Messages = append(page.Messages, newMessageResponse(message, conversation.Participants))
+	}
+
+	if len(page.Messages) > limit {
+		page.Messages = page.Messages[:limit]
+		last := page.Messages[len(page.Messages)-1]
+		nextCursor, err := pagination.EncodeCursor(chirpCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+		if err != nil {
+			log.Printf("Error encoding cursor: %s", err)
+			RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve messages")
+			return
+		}
+		page.NextCursor = nextCursor
+		w.Header().Set("Link", pagination.NextLink(r.URL, nextCursor))
+	}
+
+	RespondWithJSON(w, http.StatusOK, page)
+}
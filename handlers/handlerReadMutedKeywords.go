@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"Chirpy/internal/auth"
+)
+
+func (cfg *ApiConfig) HandlerReadMutedKeywords(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	keywords, err := cfg.DbQueries.ReadMutedKeywords(context.Background(), userID)
+	if err != nil {
+		log.Printf("Error getting muted keywords: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve muted keywords")
+		return
+	}
+	response := make([]mutedKeywordResponse, len(keywords))
+	for i, keyword := range keywords {
+		response[i] = newMutedKeywordResponse(keyword)
+	}
+
+	RespondWithJSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+	"Chirpy/internal/notifications"
+	"Chirpy/internal/pagination"
+)
+
+// HandlerReadNotifications lists the caller's notifications, most recently
+// updated first. Notifications about chirps the caller can no longer see
+// are left out.
+func (cfg *ApiConfig) HandlerReadNotifications(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	query := r.URL.Query()
+	types, err := notifications.ParseTypes(query["type"])
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limit, err := pagination.ParseLimit(query.Get("limit"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	params := database.ReadNotificationsParams{
+		UserID:   userID,
+		Types:    types,
+		RowLimit: int32(limit + 1),
+	}
+	if cursorParam := query.Get("cursor"); cursorParam != "" {
+		var cursor notificationCursor
+		err = pagination.DecodeCursor(cursorParam, &cursor)
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, "Invalid cursor")
+			return
+		}
+		params.CursorUpdatedAt = sql.NullTime{Time: cursor.UpdatedAt, Valid: true}
+		params.CursorID = uuid.NullUUID{UUID: cursor.ID, Valid: true}
+	}
+
+	ctx := context.Background()
+	rows, err := cfg.DbQueries.ReadNotifications(ctx, params)
+	if err != nil {
+		log.Printf("Error getting notifications: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve notifications")
+		return
+	}
+
+	entries, err := cfg.buildNotificationEntries(ctx, rows)
+	if err != nil {
+		log.Printf("Error getting notification actors: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve notifications")
+		return
+	}
+
+	respondWithNotificationPage(w, r, entries, limit)
+}
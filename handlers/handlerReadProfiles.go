@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+	"Chirpy/internal/entities"
+)
+
+// HandlerReadProfiles returns a user's public profile, looked up by ID or
+// by handle with or without the leading @. Users on the other side of a
+// block from the caller are reported as not found.
+func (cfg *ApiConfig) HandlerReadProfiles(w http.ResponseWriter, r *http.Request) {
+	idOrHandle := r.PathValue("idOrHandle")
+
+	params := database.ReadUserProfileParams{ViewerID: cfg.viewerID(r)}
+	if userID, err := uuid.Parse(idOrHandle); err == nil {
+		params.ID = uuid.NullUUID{UUID: userID, Valid: true}
+	} else {
+		handle := entities.NormalizeHandle(idOrHandle)
+		if !entities.ValidHandle(handle) {
+			RespondWithError(w, http.StatusBadRequest, "Invalid user ID or handle")
+			return
+		}
+		params.Handle = sql.NullString{String: handle, Valid: true}
+	}
+
+	profile, err := cfg.DbQueries.ReadUserProfile(context.Background(), params)
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "User not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting profile: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve profile")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, cfg.newProfileResponse(profile))
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+
+	"Chirpy/internal/database"
+)
+
+// HandlerReadTrends returns the hashtags from the latest trends snapshot,
+// each with a few recent chirps the viewer is allowed to see. Hashtags
+// flagged since the snapshot was taken are left out straight away.
+func (cfg *ApiConfig) HandlerReadTrends(w http.ResponseWriter, r *http.Request) {
+	ctx := context.Background()
+	viewerID := cfg.viewerID(r)
+
+	snapshot, err := cfg.DbQueries.ReadLatestTrendSnapshot(ctx)
+	if err == sql.ErrNoRows {
+		RespondWithJSON(w, http.StatusOK, trendsResponse{Trends: []trendResponse{}})
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting trend snapshot: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve trends")
+		return
+	}
+
+	trends, err := cfg.DbQueries.ReadTrends(ctx, snapshot.ID)
+	if err != nil {
+		log.Printf("Error getting trends: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve trends")
+		return
+	}
+
+	preference, err := cfg.sensitiveContentPreference(ctx, viewerID)
+	if err != nil {
+		log.Printf("Error getting sensitive content preference: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve trends")
+		return
+	}
+
+	tags := make([]string, len(trends))
+	for i, trend := range trends {
+		tags[i] = trend.Tag
+	}
+	samples, err := cfg.DbQueries.ReadTrendSampleChirps(ctx, database.ReadTrendSampleChirpsParams{
+		Tags:             tags,
+		Since:            snapshot.WindowStart,
+		ViewerID:         viewerID,
+		ExcludeSensitive: preference == sensitiveContentHide,
+		ChirpsPerTag:     trendSampleChirps,
+	})
+	if err != nil {
+		log.Printf("Error getting trend sample chirps: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve trends")
+		return
+	}
+
+	chirps := make([]database.Chirp, len(samples))
+	for i, sample := range samples {
+		chirps[i] = sample.Chirp
+	}
+	chirpResponses, err := cfg.buildChirpResponses(ctx, chirps, viewerID)
+	if err != nil {
+		log.Printf("Error building chirp responses: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve trends")
+		return
+	}
+	samplesByTag := map[string][]chirpResponse{}
+	for i, sample := range samples {
+		samplesByTag[sample.Tag] = append(samplesByTag[sample.Tag], chirpResponses[i])
+	}
+
+	response := trendsResponse{
+		ComputedAt:  &snapshot.CreatedAt,
+		WindowStart: &snapshot.WindowStart,
+		Trends:      make([]trendResponse, len(trends)),
+	}
+	for i, trend := range trends {
+		response.Trends[i] = newTrendResponse(trend)
+		if sampleChirps, ok := samplesByTag[trend.Tag]; ok {
+			response.Trends[i].SampleChirps = sampleChirps
+		}
+	}
+
+	RespondWithJSON(w, http.StatusOK, response)
+}
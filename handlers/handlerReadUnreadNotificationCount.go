@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"Chirpy/internal/auth"
+)
+
+func (cfg *ApiConfig) HandlerReadUnreadNotificationCount(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	type returnVals struct {
+		Count int64 `json:"count"`
+	}
+
+	count, err := cfg.DbQueries.CountUnreadNotifications(context.Background(), userID)
+	if err != nil {
+		log.Printf("Error counting unread notifications: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to count notifications")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, returnVals{Count: count})
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+func (cfg *ApiConfig) HandlerRescheduleChirp(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	chirpID, err := uuid.Parse(r.PathValue("chirpID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid chirp ID")
+		return
+	}
+
+	type parameters struct {
+		PublishAt *time.Time `json:"publish_at"`
+	}
+	params := parameters{}
+
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&params)
+	if err != nil {
+		log.Printf("Error decoding parameters: %s", err)
+		RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if params.PublishAt == nil {
+		RespondWithError(w, http.StatusBadRequest, "publish_at is required")
+		return
+	}
+	publishAt, err := parsePublishAt(params.PublishAt)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx := context.Background()
+	polls, err := cfg.DbQueries.ReadPollsByChirpIDs(ctx, []uuid.UUID{chirpID})
+	if err != nil {
+		log.Printf("Error getting poll: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to reschedule chirp")
+		return
+	}
+	if len(polls) > 0 && polls[0].ClosesAt.Sub(publishAt.Time) < minPollDuration {
+		RespondWithError(w, http.StatusBadRequest, "publish_at must be at least 5 minutes before the poll closes")
+		return
+	}
+
+	chirp, err := cfg.DbQueries.RescheduleChirp(ctx, database.RescheduleChirpParams{
+		ID:        chirpID,
+		UserID:    uuid.NullUUID{UUID: userID, Valid: true},
+		PublishAt: publishAt,
+	})
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "Scheduled chirp not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error rescheduling chirp: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to reschedule chirp")
+		return
+	}
+
+	response, err := cfg.buildChirpResponse(ctx, chirp, uuid.NullUUID{UUID: userID, Valid: true})
+	if err != nil {
+		log.Printf("Error building chirp response: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to reschedule chirp")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+	"Chirpy/internal/pagination"
+	"Chirpy/internal/search"
+)
+
+type searchCursor struct {
+	Rank float32   `json:"rank"`
+	ID   uuid.UUID `json:"id"`
+}
+
+func (cfg *ApiConfig) HandlerSearchChirps(w http.ResponseWriter, r *http.Request) {
+	query, err := search.Parse(r.URL.Query().Get("q"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limit, err := pagination.ParseLimit(r.URL.Query().Get("limit"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	searchParams := database.SearchChirpsParams{
+		Query:    query.Text,
+		ViewerID: cfg.viewerID(r),
+		Hashtags: query.Hashtags,
+		RowLimit: int32(limit + 1),
+	}
+
+	if cursorParam := r.URL.Query().Get("cursor"); cursorParam != "" {
+		var cursor searchCursor
+		err = pagination.DecodeCursor(cursorParam, &cursor)
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, "Invalid cursor")
+			return
+		}
+		searchParams.CursorRank = sql.NullFloat64{Float64: float64(cursor.Rank), Valid: true}
+		searchParams.CursorID = uuid.NullUUID{UUID: cursor.ID, Valid: true}
+	}
+	if !query.Since.IsZero() {
+		searchParams.Since = sql.NullTime{Time: query.Since, Valid: true}
+	}
+	if !query.Until.IsZero() {
+		searchParams.Until = sql.NullTime{Time: query.Until, Valid: true}
+	}
+
+	type searchResult struct {
+		chirpResponse
+		Rank    float32 `json:"rank"`
+		Snippet string  `json:"snippet"`
+	}
+
+	type searchResponse struct {
+		Chirps     []searchResult `json:"chirps"`
+		NextCursor string         `json:"next_cursor"`
+	}
+
+	ctx := context.Background()
+	if query.From != "" {
+		author, err := cfg.DbQueries.ReadUserByHandle(ctx, sql.NullString{String: query.From, Valid: true})
+		if err == sql.ErrNoRows {
+			RespondWithJSON(w, http.StatusOK, searchResponse{Chirps: []searchResult{}})
+			return
+		}
+		if err != nil {
+			log.Printf("Error getting user by handle: %s", err)
+			RespondWithError(w, http.StatusInternalServerError, "Failed to search chirps")
+			return
+		}
+		searchParams.AuthorID = uuid.NullUUID{UUID: author.ID, Valid: true}
+	}
+
+	rows, err := cfg.DbQueries.SearchChirps(ctx, searchParams)
+	if err != nil {
+		log.Printf("Error searching chirps: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to search chirps")
+		return
+	}
+
+	response := searchResponse{Chirps: []searchResult{}}
+	if len(rows) > limit {
+		rows = rows[:limit]
+		last := rows[len(rows)-1]
+		response.NextCursor, err = pagination.EncodeCursor(searchCursor{Rank: last.Rank, ID: last.ID})
+		if err != nil {
+			log.Printf("Error encoding cursor: %s", err)
+			RespondWithError(w, http.StatusInternalServerError, "Failed to search chirps")
+			return
+		}
+		w.Header().Set("Link", pagination.NextLink(r.URL, response.NextCursor))
+	}
+
+	chirps := make([]database.Chirp, len(rows))
+	for i, row := range rows {
+		chirps[i] = database.Chirp{
+			ID:             row.ID,
+			CreatedAt:      row.CreatedAt,
+			UpdatedAt:      row.UpdatedAt,
+			Body:           row.Body,
+			UserID:         row.UserID,
+			PublishAt:      row.PublishAt,
+			Published:      row.Published,
+			DeletedAt:      row.DeletedAt,
+			DeletionReason: row.DeletionReason,
+			Visibility:     row.Visibility,
+			ContentWarning: row.ContentWarning,
+			Sensitive:      row.Sensitive,
+			ReplyToID:      row.ReplyToID,
+			ReplyCount:     row.ReplyCount,
+			LikeCount:      row.LikeCount,
+		}
+	}
+
+	chirpResponses, err := cfg.buildChirpResponses(ctx, chirps, cfg.viewerID(r))
+	if err != nil {
+		log.Printf("Error building chirp responses: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to search chirps")
+		return
+	}
+
+	for i, row := range rows {
+		response.Chirps = append(response.Chirps, searchResult{
+			chirpResponse: chirpResponses[i],
+			Rank:          row.Rank,
+			Snippet:       row.Snippet,
+		})
+	}
+
+	RespondWithJSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+func (cfg *ApiConfig) HandlerUnblockUsers(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	otherID, err := uuid.Parse(r.PathValue("userID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	deleted, err := cfg.DbQueries.DeleteBlock(context.Background(), database.DeleteBlockParams{
+		BlockerID: userID,
+		BlockedID: otherID,
+	})
+	if err != nil {
+		log.Printf("Error unblocking user: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to unblock user")
+		return
+	}
+	if deleted == 0 {
+		RespondWithError(w, http.StatusNotFound, "User is not blocked")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+func (cfg *ApiConfig) HandlerUnfollowUsers(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	followeeID, err := uuid.Parse(r.PathValue("userID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	ctx := context.Background()
+	tx, err := cfg.Db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("Error starting transaction: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to unfollow user")
+		return
+	}
+	defer tx.Rollback()
+	qtx := cfg.DbQueries.WithTx(tx)
+
+	deleted, err := qtx.UnfollowUser(ctx, database.UnfollowUserParams{
+		FollowerID: userID,
+		FolloweeID: followeeID,
+	})
+	if err != nil {
+		log.Printf("Error unfollowing user: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to unfollow user")
+		return
+	}
+
+	// Unfollowing a protected account that hasn't approved the follow yet
+	// withdraws the request.
+	withdrawn, err := qtx.DeleteFollowRequest(ctx, database.DeleteFollowRequestParams{
+		RequesterID: userID,
+		TargetID:    followeeID,
+	})
+	if err != nil {
+		log.Printf("Error withdrawing follow request: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to unfollow user")
+		return
+	}
+	if deleted == 0 && withdrawn == 0 {
+		RespondWithError(w, http.StatusNotFound, "You are not following this user")
+		return
+	}
+
+	err = qtx.DeleteHomeTimelineAuthor(ctx, database.DeleteHomeTimelineAuthorParams{
+		UserID:   userID,
+		AuthorID: followeeID,
+	})
+	if err != nil {
+		log.Printf("Error removing chirps from home timeline: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to unfollow user")
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Error committing unfollow: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to unfollow user")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+// HandlerUpdateConversations changes a conversation's settings. Any
+// participant may do so; the profanity filter applies to messages sent
+// after the change.
+func (cfg *ApiConfig) HandlerUpdateConversations(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	conversationID, err := uuid.Parse(r.PathValue("conversationID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid conversation ID")
+		return
+	}
+
+	type parameters struct {
+		FilterProfanity *bool `json:"filter_profanity"`
+	}
+	params := parameters{}
+
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&params)
+	if err != nil {
+		log.Printf("Error decoding parameters: %s", err)
+		RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if params.FilterProfanity == nil {
+		RespondWithError(w, http.StatusBadRequest, "filter_profanity is required")
+		return
+	}
+
+	ctx := context.Background()
+	_, err = cfg.DbQueries.UpdateConversation(ctx, database.UpdateConversationParams{
+		FilterProfanity: *params.FilterProfanity,
+		ID:              conversationID,
+		UserID:          userID,
+	})
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "Conversation not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error updating conversation: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to update conversation")
+		return
+	}
+
+	response, err := cfg.readConversation(ctx, conversationID, userID)
+	if err != nil {
+		log.Printf("Error getting conversation: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to update conversation")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+func (cfg *ApiConfig) HandlerUpdateDrafts(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	draftID, err := uuid.Parse(r.PathValue("draftID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid draft ID")
+		return
+	}
+
+	type parameters struct {
+		Body           string `json:"body"`
+		Visibility     string `json:"visibility"`
+		ContentWarning string `json:"content_warning"`
+		Sensitive      bool   `json:"sensitive"`
+	}
+	params := parameters{}
+
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&params)
+	if err != nil {
+		log.Printf("Error decoding parameters: %s", err)
+		RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	ctx := context.Background()
+	maxLength, err := cfg.chirpLengthLimit(ctx, cfg.DbQueries, userID)
+	if err != nil {
+		log.Printf("Error getting chirp length limit: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to update draft")
+		return
+	}
+
+	body, err := normalizeChirpBody(params.Body, maxLength)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	visibility, err := parseVisibility(params.Visibility)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	contentWarning, err := parseContentWarning(params.ContentWarning)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	draft, err := cfg.DbQueries.UpdateDraft(ctx, database.UpdateDraftParams{
+		ID:             draftID,
+		UserID:         userID,
+		Body:           body,
+		Visibility:     visibility,
+		ContentWarning: contentWarning,
+		Sensitive:      params.Sensitive,
+	})
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "Draft not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error updating draft: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to update draft")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, newDraftResponse(draft))
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+// HandlerUpdateProfiles replaces the caller's public profile. The avatar
+// must be an image the caller uploaded that isn't attached to a chirp; a
+// null avatar_media_id removes it.
+func (cfg *ApiConfig) HandlerUpdateProfiles(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	params := profileParameters{}
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&params)
+	if err != nil {
+		log.Printf("Error decoding parameters: %s", err)
+		RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	err = params.validate()
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx := context.Background()
+	avatarMediaID := uuid.NullUUID{}
+	if params.AvatarMediaID != nil {
+		attachment, err := cfg.DbQueries.ReadMediaAttachmentByID(ctx, database.ReadMediaAttachmentByIDParams{
+			ID:     *params.AvatarMediaID,
+			UserID: userID,
+		})
+		if err != nil && err != sql.ErrNoRows {
+			log.Printf("Error getting avatar media: %s", err)
+			RespondWithError(w, http.StatusInternalServerError, "Failed to update profile")
+			return
+		}
+		if err == sql.ErrNoRows || attachment.ChirpID.Valid {
+			RespondWithError(w, http.StatusBadRequest, "Avatar must be uploaded by you and not attached to a chirp")
+			return
+		}
+		avatarMediaID = uuid.NullUUID{UUID: attachment.ID, Valid: true}
+	}
+
+	_, err = cfg.DbQueries.UpdateUserProfile(ctx, database.UpdateUserProfileParams{
+		ID:            userID,
+		DisplayName:   params.DisplayName,
+		Bio:           params.Bio,
+		Location:      params.Location,
+		Website:       params.Website,
+		AvatarMediaID: avatarMediaID,
+	})
+	if err != nil {
+		log.Printf("Error updating profile: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to update profile")
+		return
+	}
+
+	profile, err := cfg.DbQueries.ReadUserProfile(ctx, database.ReadUserProfileParams{
+		ID: uuid.NullUUID{UUID: userID, Valid: true},
+	})
+	if err != nil {
+		log.Printf("Error getting profile: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to update profile")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, cfg.newProfileResponse(profile))
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+type userSettingsResponse struct {
+	SensitiveContent string `json:"sensitive_content"`
+	Protected        bool   `json:"protected"`
+}
+
+// HandlerUpdateUserSettings changes the settings given in the body and
+// leaves the rest as they are. Unlike PUT /api/users it doesn't need the
+// user's email and password.
+func (cfg *ApiConfig) HandlerUpdateUserSettings(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	type parameters struct {
+		SensitiveContent string `json:"sensitive_content"`
+		Protected        *bool  `json:"protected"`
+	}
+	params := parameters{}
+
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&params)
+	if err != nil {
+		log.Printf("Error decoding parameters: %s", err)
+		RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	sensitiveContent, err := parseSensitiveContentPreference(params.SensitiveContent)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	updateSettingsParams := database.UpdateUserSettingsParams{
+		ID:               userID,
+		SensitiveContent: sensitiveContent,
+	}
+	if params.Protected != nil {
+		updateSettingsParams.Protected = sql.NullBool{Bool: *params.Protected, Valid: true}
+	}
+
+	ctx := context.Background()
+	tx, err := cfg.Db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("Error starting transaction: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to update settings")
+		return
+	}
+	defer tx.Rollback()
+	qtx := cfg.DbQueries.WithTx(tx)
+
+	updatedUser, err := qtx.UpdateUserSettings(ctx, updateSettingsParams)
+	if err != nil {
+		log.Printf("Error updating user settings: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to update settings")
+		return
+	}
+
+	// Requests left waiting when an account stops being protected are
+	// approved, as they would be if they were made now. UpdateUserSettings
+	// has locked this user's row, which blockUser locks too, so none of them
+	// can be for someone blocked meanwhile.
+	if !updatedUser.Protected {
+		requesterIDs, err := qtx.TakeFollowRequests(ctx, userID)
+		if err != nil {
+			log.Printf("Error taking follow requests: %s", err)
+			RespondWithError(w, http.StatusInternalServerError, "Failed to update settings")
+			return
+		}
+		for _, requesterID := range requesterIDs {
+			err = approveFollowRequest(ctx, qtx, requesterID, userID)
+			if err != nil {
+				log.Printf("Error approving follow request: %s", err)
+				RespondWithError(w, http.StatusInternalServerError, "Failed to update settings")
+				return
+			}
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Error committing settings update: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to update settings")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, userSettingsResponse{
+		SensitiveContent: updatedUser.SensitiveContent,
+		Protected:        updatedUser.Protected,
+	})
+}
@@ -29,6 +29,7 @@ func (cfg *ApiConfig) HandlerUpdateUsers(w http.ResponseWriter, r *http.Request)
 	type parameters struct {
 		Email    string `json:"email"`
 		Password string `json:"password"`
+		Handle   string `json:"handle"`
 	}
 	params := parameters{}
 
@@ -52,13 +53,26 @@ func (cfg *ApiConfig) HandlerUpdateUsers(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	ctx := context.Background()
+	handle, err := cfg.claimHandle(ctx, params.Handle, userID)
+	if err != nil {
+		log.Printf("Error claiming handle: %s", err)
+		respondWithHandleError(w, err)
+		return
+	}
+
 	updateUserParams := database.UpdateUserParams{
-		ID: userID,
-		Email: params.Email,
+		ID:             userID,
+		Email:          params.Email,
 		HashedPassword: hashedPassword,
+		Handle:         handle,
 	}
 
-	updatedUser, err := cfg.DbQueries.UpdateUser(context.Background(), updateUserParams)
+	updatedUser, err := cfg.DbQueries.UpdateUser(ctx, updateUserParams)
+	if handleWriteError(err) == errHandleTaken {
+		respondWithHandleError(w, errHandleTaken)
+		return
+	}
 	if err != nil {
 		log.Printf("Error updating user: %s", err)
 		RespondWithError(w, http.StatusInternalServerError, "Failed to update user")
@@ -66,19 +80,25 @@ func (cfg *ApiConfig) HandlerUpdateUsers(w http.ResponseWriter, r *http.Request)
 	}
 
 	type userResponse struct {
-		ID          uuid.UUID `json:"id"`
-		CreatedAt   time.Time `json:"created_at"`
-		UpdatedAt   time.Time `json:"updated_at"`
-		Email       string    `json:"email"`
-		IsChirpyRed bool      `json:"is_chirpy_red"`
+		ID               uuid.UUID `json:"id"`
+		CreatedAt        time.Time `json:"created_at"`
+		UpdatedAt        time.Time `json:"updated_at"`
+		Email            string    `json:"email"`
+		IsChirpyRed      bool      `json:"is_chirpy_red"`
+		Handle           string    `json:"handle"`
+		SensitiveContent string    `json:"sensitive_content"`
+		Protected        bool      `json:"protected"`
 	}
 
 	response := userResponse{
-		ID:          updatedUser.ID,
-		CreatedAt:   updatedUser.CreatedAt,
-		UpdatedAt:   updatedUser.UpdatedAt,
-		Email:       updatedUser.Email,
-		IsChirpyRed: updatedUser.IsChirpyRed,
+		ID:               updatedUser.ID,
+		CreatedAt:        updatedUser.CreatedAt,
+		UpdatedAt:        updatedUser.UpdatedAt,
+		Email:            updatedUser.Email,
+		IsChirpyRed:      updatedUser.IsChirpyRed,
+		Handle:           updatedUser.Handle.String,
+		SensitiveContent: updatedUser.SensitiveContent,
+		Protected:        updatedUser.Protected,
 	}
 
     RespondWithJSON(w, http.StatusOK, response)
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+	"Chirpy/internal/media"
+)
+
+func (cfg *ApiConfig) HandlerUploadMedia(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	// Leave some room for the multipart framing around the file itself.
+	r.Body = http.MaxBytesReader(w, r.Body, media.MaxUploadBytes+1<<20)
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			RespondWithError(w, http.StatusRequestEntityTooLarge, "File is too large")
+			return
+		}
+		RespondWithError(w, http.StatusBadRequest, "Missing file")
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, media.MaxUploadBytes+1))
+	if err != nil {
+		log.Printf("Error reading upload: %s", err)
+		RespondWithError(w, http.StatusBadRequest, "Unable to read file")
+		return
+	}
+	if len(data) > media.MaxUploadBytes {
+		RespondWithError(w, http.StatusRequestEntityTooLarge, "File is too large")
+		return
+	}
+
+	image, err := media.Process(data)
+	if err == media.ErrUnsupportedType {
+		RespondWithError(w, http.StatusUnsupportedMediaType, err.Error())
+		return
+	}
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	mediaID := uuid.New()
+	extension := ".jpg"
+	if image.ContentType == "image/png" {
+		extension = ".png"
+	}
+	blobKey := mediaID.String() + "/original" + extension
+	thumbnailKey := mediaID.String() + "/thumbnail" + extension
+
+	ctx := context.Background()
+	err = cfg.BlobStore.Put(ctx, blobKey, image.ContentType, bytes.NewReader(image.Data))
+	if err != nil {
+		log.Printf("Error storing media: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Unable to store media")
+		return
+	}
+	err = cfg.BlobStore.Put(ctx, thumbnailKey, image.ContentType, bytes.NewReader(image.Thumbnail))
+	if err != nil {
+		log.Printf("Error storing thumbnail: %s", err)
+		cfg.BlobStore.Delete(ctx, blobKey)
+		RespondWithError(w, http.StatusInternalServerError, "Unable to store media")
+		return
+	}
+
+	attachment, err := cfg.DbQueries.CreateMediaAttachment(ctx, database.CreateMediaAttachmentParams{
+		ID:           mediaID,
+		UserID:       userID,
+		ContentType:  image.ContentType,
+		Width:        int32(image.Width),
+		Height:       int32(image.Height),
+		SizeBytes:    int32(len(image.Data)),
+		BlobKey:      blobKey,
+		ThumbnailKey: thumbnailKey,
+	})
+	if err != nil {
+		log.Printf("Error creating media attachment: %s", err)
+		cfg.BlobStore.Delete(ctx, blobKey)
+		cfg.BlobStore.Delete(ctx, thumbnailKey)
+		RespondWithError(w, http.StatusInternalServerError, "Unable to store media")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusCreated, cfg.mediaEntity(attachment))
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+	"Chirpy/internal/database"
+)
+
+func (cfg *ApiConfig) HandlerVotePoll(w http.ResponseWriter, r *http.Request) {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Missing or invalid authorization header")
+		return
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		RespondWithError(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+
+	chirpID, err := uuid.Parse(r.PathValue("chirpID"))
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid chirp ID")
+		return
+	}
+
+	type parameters struct {
+		OptionID uuid.UUID `json:"option_id"`
+	}
+	params := parameters{}
+
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&params)
+	if err != nil {
+		log.Printf("Error decoding parameters: %s", err)
+		RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	ctx := context.Background()
+	chirp, err := readVisibleChirp(ctx, cfg.DbQueries, chirpID, uuid.NullUUID{UUID: userID, Valid: true})
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusNotFound, "Chirp not found")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting chirp: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to record vote")
+		return
+	}
+
+	polls, err := cfg.DbQueries.ReadPollsByChirpIDs(ctx, []uuid.UUID{chirpID})
+	if err != nil {
+		log.Printf("Error getting poll: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to record vote")
+		return
+	}
+	if len(polls) == 0 {
+		RespondWithError(w, http.StatusNotFound, "Chirp has no poll")
+		return
+	}
+	if !polls[0].ClosesAt.After(time.Now().UTC()) {
+		RespondWithError(w, http.StatusConflict, "Poll is closed")
+		return
+	}
+
+	// The query re-checks the close time itself, so a vote racing the close
+	// can't slip in after it.
+	_, err = cfg.DbQueries.CastPollVote(ctx, database.CastPollVoteParams{
+		UserID:   userID,
+		ChirpID:  chirpID,
+		OptionID: params.OptionID,
+	})
+	if err == sql.ErrNoRows {
+		RespondWithError(w, http.StatusBadRequest, "Invalid option or poll is closed")
+		return
+	}
+	if err != nil {
+		log.Printf("Error casting vote: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to record vote")
+		return
+	}
+
+	response, err := cfg.buildChirpResponse(ctx, chirp, uuid.NullUUID{UUID: userID, Valid: true})
+	if err != nil {
+		log.Printf("Error building chirp response: %s", err)
+		RespondWithError(w, http.StatusInternalServerError, "Failed to record vote")
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"Chirpy/internal/entities"
+)
+
+var (
+	errInvalidHandle = errors.New("handle must be 1-15 letters, numbers or underscores")
+	errHandleTaken   = errors.New("handle is already taken")
+)
+
+// reservedHandles are path segments under /api/users, so a profile using
+// one could never be looked up by handle.
+var reservedHandles = []string{"me", "suggestions"}
+
+// handleConstraint is the unique constraint on users.handle.
+const handleConstraint = "users_handle_key"
+
+// claimHandle normalizes a requested handle and checks that it is free for
+// userID to use. An empty request yields a NULL handle. The check is only a
+// fast path: a concurrent claim can still take the handle before the write,
+// so callers pass the write's error through handleWriteError as well.
+func (cfg *ApiConfig) claimHandle(ctx context.Context, requested string, userID uuid.UUID) (sql.NullString, error) {
+	handle := entities.NormalizeHandle(requested)
+	if handle == "" {
+		return sql.NullString{}, nil
+	}
+	if !entities.ValidHandle(handle) {
+		return sql.NullString{}, errInvalidHandle
+	}
+	if slices.Contains(reservedHandles, handle) {
+		return sql.NullString{}, errHandleTaken
+	}
+
+	nullHandle := sql.NullString{String: handle, Valid: true}
+	existing, err := cfg.DbQueries.ReadUserByHandle(ctx, nullHandle)
+	if err == nil && existing.ID != userID {
+		return sql.NullString{}, errHandleTaken
+	}
+	if err != nil && err != sql.ErrNoRows {
+		return sql.NullString{}, err
+	}
+
+	return nullHandle, nil
+}
+
+// handleWriteError reports a unique violation on the handle, left by a claim
+// that raced with claimHandle's check, as errHandleTaken. Other errors are
+// returned unchanged.
+func handleWriteError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == handleConstraint {
+		return errHandleTaken
+	}
+	return err
+}
+
+func respondWithHandleError(w http.ResponseWriter, err error) {
+	switch err {
+	case errInvalidHandle:
+		RespondWithError(w, http.StatusBadRequest, "Invalid handle")
+	case errHandleTaken:
+		RespondWithError(w, http.StatusConflict, "Handle is already taken")
+	default:
+		RespondWithError(w, http.StatusInternalServerError, "Failed to check handle")
+	}
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+	"Chirpy/internal/entities"
+)
+
+const (
+	linkCodeLength   = 8
+	linkCodeAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+var errDeniedLink = errors.New("Chirp links to a blocked domain")
+
+type linkEntity struct {
+	URL         string `json:"url"`
+	ExpandedURL string `json:"expanded_url"`
+	DisplayURL  string `json:"display_url"`
+	Indices     [2]int `json:"indices"`
+}
+
+// checkLinks rejects bodies linking to a domain, or any subdomain of one, on
+// the configured denylist.
+func (cfg *ApiConfig) checkLinks(body string) error {
+	for _, link := range entities.ParseURLs(body) {
+		if cfg.linkDenied(link.URL) {
+			return errDeniedLink
+		}
+	}
+	return nil
+}
+
+// linkDenied reports whether url points at a domain, or any subdomain of one,
+// on the configured denylist.
+func (cfg *ApiConfig) linkDenied(url string) bool {
+	host := entities.URLHost(url)
+	for _, domain := range cfg.DeniedLinkDomains {
+		if entities.HostInDomain(host, domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// createLinks shortens every URL in a chirp body. A URL that was shortened
+// before keeps its code, so clicks are counted per destination rather than
+// per chirp.
+func createLinks(ctx context.Context, q *database.Queries, chirp database.Chirp) error {
+	for _, url := range entities.ParseURLs(chirp.Body) {
+		code, err := newLinkCode()
+		if err != nil {
+			return err
+		}
+
+		link, err := q.CreateLink(ctx, database.CreateLinkParams{
+			Code: code,
+			Url:  url.URL,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.CreateChirpLink(ctx, database.CreateChirpLinkParams{
+			ChirpID: chirp.ID,
+			LinkID:  link.ID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func newLinkCode() (string, error) {
+	randomBytes := make([]byte, linkCodeLength)
+	_, err := rand.Read(randomBytes)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+
+	code := make([]byte, linkCodeLength)
+	for i, b := range randomBytes {
+		code[i] = linkCodeAlphabet[int(b)%len(linkCodeAlphabet)]
+	}
+	return string(code), nil
+}
+
+// linkEntities pairs the URLs in body with their short codes. codes maps each
+// expanded URL to its code.
+func linkEntities(body string, codes map[string]string) []linkEntity {
+	result := []linkEntity{}
+	for _, url := range entities.ParseURLs(body) {
+		code, ok := codes[url.URL]
+		if !ok {
+			continue
+		}
+		result = append(result, linkEntity{
+			URL:         "/l/" + code,
+			ExpandedURL: url.URL,
+			DisplayURL:  entities.DisplayURL(url.URL),
+			Indices:     [2]int{url.Start, url.End},
+		})
+	}
+	return result
+}
+
+func (cfg *ApiConfig) readLinkCodes(ctx context.Context, chirpIDs []uuid.UUID) (map[uuid.UUID]map[string]string, error) {
+	links, err := cfg.DbQueries.ReadLinksByChirpIDs(ctx, chirpIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	codes := map[uuid.UUID]map[string]string{}
+	for _, link := range links {
+		if codes[link.ChirpID] == nil {
+			codes[link.ChirpID] = map[string]string{}
+		}
+		codes[link.ChirpID][link.Url] = link.Code
+	}
+	return codes, nil
+}
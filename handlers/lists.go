@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+)
+
+const (
+	listVisibilityPublic  = "public"
+	listVisibilityPrivate = "private"
+
+	maxListNameChars        = 50
+	maxListDescriptionChars = 160
+	maxListMembers          = 500
+)
+
+type listResponse struct {
+	ID          uuid.UUID `json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	UserID      uuid.UUID `json:"user_id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Visibility  string    `json:"visibility"`
+	MemberCount int64     `json:"member_count"`
+}
+
+type listParameters struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Visibility  string `json:"visibility"`
+}
+
+func newListResponse(list database.List, memberCount int64) listResponse {
+	return listResponse{
+		ID:          list.ID,
+		CreatedAt:   list.CreatedAt,
+		UpdatedAt:   list.UpdatedAt,
+		UserID:      list.UserID,
+		Name:        list.Name,
+		Description: list.Description,
+		Visibility:  list.Visibility,
+		MemberCount: memberCount,
+	}
+}
+
+// validate trims the list's name and description and defaults its
+// visibility to public.
+func (params *listParameters) validate() error {
+	params.Name = strings.TrimSpace(params.Name)
+	params.Description = strings.TrimSpace(params.Description)
+
+	if params.Name == "" {
+		return errors.New("name is required")
+	}
+	if utf8.RuneCountInString(params.Name) > maxListNameChars {
+		return errors.New("name must be 50 characters or fewer")
+	}
+	if utf8.RuneCountInString(params.Description) > maxListDescriptionChars {
+		return errors.New("description must be 160 characters or fewer")
+	}
+
+	switch params.Visibility {
+	case "":
+		params.Visibility = listVisibilityPublic
+	case listVisibilityPublic, listVisibilityPrivate:
+	default:
+		return errors.New("visibility must be public or private")
+	}
+	return nil
+}
+
+// readOwnedList loads a list owned by userID. Someone else's list is
+// reported as sql.ErrNoRows, the same as a missing one.
+func readOwnedList(ctx context.Context, q *database.Queries, listID, userID uuid.UUID) (database.ReadListByIDRow, error) {
+	row, err := q.ReadListByID(ctx, database.ReadListByIDParams{
+		ID:       listID,
+		ViewerID: uuid.NullUUID{UUID: userID, Valid: true},
+	})
+	if err != nil {
+		return database.ReadListByIDRow{}, err
+	}
+	if row.List.UserID != userID {
+		return database.ReadListByIDRow{}, sql.ErrNoRows
+	}
+	return row, nil
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+	"Chirpy/internal/notifications"
+	"Chirpy/internal/pagination"
+)
+
+type notificationCursor struct {
+	UpdatedAt time.Time `json:"updated_at"`
+	ID        uuid.UUID `json:"id"`
+}
+
+type notificationActor struct {
+	ID     uuid.UUID `json:"id"`
+	Handle string    `json:"handle"`
+}
+
+type notificationEntry struct {
+	ID         uuid.UUID           `json:"id"`
+	Type       string              `json:"type"`
+	ChirpID    *uuid.UUID          `json:"chirp_id"`
+	Summary    string              `json:"summary"`
+	Actors     []notificationActor `json:"actors"`
+	ActorCount int64               `json:"actor_count"`
+	Read       bool                `json:"read"`
+	CreatedAt  time.Time           `json:"created_at"`
+	UpdatedAt  time.Time           `json:"updated_at"`
+}
+
+type notificationPage struct {
+	Notifications []notificationEntry `json:"notifications"`
+	NextCursor    string              `json:"next_cursor"`
+}
+
+// buildNotificationEntries loads the most recent actors of each notification
+// in one query and summarizes the groups.
+func (cfg *ApiConfig) buildNotificationEntries(ctx context.Context, rows []database.ReadNotificationsRow) ([]notificationEntry, error) {
+	notificationIDs := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		notificationIDs[i] = row.Notification.ID
+	}
+
+	actorRows, err := cfg.DbQueries.ReadNotificationActors(ctx, database.ReadNotificationActorsParams{
+		NotificationIds:       notificationIDs,
+		ActorsPerNotification: notifications.SummaryActors,
+	})
+	if err != nil {
+		return nil, err
+	}
+	actors := map[uuid.UUID][]notificationActor{}
+	for _, actor := range actorRows {
+		actors[actor.NotificationID] = append(actors[actor.NotificationID], notificationActor{
+			ID:     actor.ID,
+			Handle: actor.Handle.String,
+		})
+	}
+
+	entries := make([]notificationEntry, len(rows))
+	for i, row := range rows {
+		notification := row.Notification
+		entry := notificationEntry{
+			ID:         notification.ID,
+			Type:       notification.Type,
+			Actors:     actors[notification.ID],
+			ActorCount: row.ActorCount,
+			Read:       notification.ReadAt.Valid,
+			CreatedAt:  notification.CreatedAt,
+			UpdatedAt:  notification.UpdatedAt,
+		}
+		if entry.Actors == nil {
+			entry.Actors = []notificationActor{}
+		}
+		if notification.ChirpID.Valid {
+			chirpID := notification.ChirpID.UUID
+			entry.ChirpID = &chirpID
+		}
+
+		handles := make([]string, len(entry.Actors))
+		for j, actor := range entry.Actors {
+			handles[j] = actor.Handle
+		}
+		entry.Summary = notifications.Summary(notification.Type, handles, row.ActorCount)
+		entries[i] = entry
+	}
+	return entries, nil
+}
+
+// respondWithNotificationPage writes one page of notifications. The query
+// should have been asked for limit+1 rows so that the extra row signals
+// there is a next page.
+func respondWithNotificationPage(w http.ResponseWriter, r *http.Request, entries []notificationEntry, limit int) {
+	page := notificationPage{Notifications: entries}
+
+	if len(entries) > limit {
+		page.Notifications = entries[:limit]
+		last := page.Notifications[len(page.Notifications)-1]
+		nextCursor, err := pagination.EncodeCursor(notificationCursor{UpdatedAt: last.UpdatedAt, ID: last.ID})
+		if err != nil {
+			log.Printf("Error encoding cursor: %s", err)
+			RespondWithError(w, http.StatusInternalServerError, "Failed to retrieve notifications")
+			return
+		}
+		page.NextCursor = nextCursor
+		w.Header().Set("Link", pagination.NextLink(r.URL, nextCursor))
+	}
+
+	RespondWithJSON(w, http.StatusOK, page)
+}
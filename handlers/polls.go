@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+)
+
+const (
+	minPollOptions     = 2
+	maxPollOptions     = 4
+	maxPollOptionChars = 25
+	minPollDuration    = 5 * time.Minute
+	maxPollDuration    = 7 * 24 * time.Hour
+)
+
+// Poll result visibility settings. Results are always shown once a poll has
+// closed; before that they depend on the poll's setting.
+const (
+	pollResultsAlways     = "always"
+	pollResultsAfterVote  = "after_vote"
+	pollResultsAfterClose = "after_close"
+)
+
+type pollParameters struct {
+	Options           []string  `json:"options"`
+	ClosesAt          time.Time `json:"closes_at"`
+	ResultsVisibility string    `json:"results_visibility"`
+}
+
+type pollOptionEntity struct {
+	ID    uuid.UUID `json:"id"`
+	Label string    `json:"label"`
+	Votes *int64    `json:"votes"`
+}
+
+type pollEntity struct {
+	ID                uuid.UUID          `json:"id"`
+	ClosesAt          time.Time          `json:"closes_at"`
+	Closed            bool               `json:"closed"`
+	ResultsVisibility string             `json:"results_visibility"`
+	Options           []pollOptionEntity `json:"options"`
+	TotalVotes        *int64             `json:"total_votes"`
+	ViewerVote        *uuid.UUID         `json:"viewer_vote"`
+}
+
+// validatePoll checks a poll submitted with a new chirp. opensAt is when the
+// chirp becomes visible, so a scheduled chirp's poll is measured from its
+// publish time rather than from now.
+func validatePoll(poll *pollParameters, opensAt time.Time) error {
+	if len(poll.Options) < minPollOptions || len(poll.Options) > maxPollOptions {
+		return errors.New("A poll must have between 2 and 4 options")
+	}
+
+	seen := map[string]bool{}
+	for i, option := range poll.Options {
+		option = strings.TrimSpace(option)
+		if option == "" {
+			return errors.New("Poll options can't be empty")
+		}
+		if utf8.RuneCountInString(option) > maxPollOptionChars {
+			return errors.New("Poll options must be 25 characters or fewer")
+		}
+		if seen[strings.ToLower(option)] {
+			return errors.New("Poll options must be unique")
+		}
+		seen[strings.ToLower(option)] = true
+		poll.Options[i] = option
+	}
+
+	duration := poll.ClosesAt.Sub(opensAt)
+	if duration < minPollDuration {
+		return errors.New("closes_at must be at least 5 minutes after the chirp is published")
+	}
+	if duration > maxPollDuration {
+		return errors.New("closes_at must be within 7 days of the chirp being published")
+	}
+
+	switch poll.ResultsVisibility {
+	case "":
+		poll.ResultsVisibility = pollResultsAlways
+	case pollResultsAlways, pollResultsAfterVote, pollResultsAfterClose:
+	default:
+		return errors.New("results_visibility must be always, after_vote or after_close")
+	}
+
+	return nil
+}
+
+// createPoll stores a validated poll and its options. q should be the
+// transaction the chirp was created in.
+func createPoll(ctx context.Context, q *database.Queries, chirpID uuid.UUID, poll pollParameters) error {
+	created, err := q.CreatePoll(ctx, database.CreatePollParams{
+		ChirpID:           chirpID,
+		ClosesAt:          poll.ClosesAt.UTC(),
+		ResultsVisibility: poll.ResultsVisibility,
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, option := range poll.Options {
+		err = q.CreatePollOption(ctx, database.CreatePollOptionParams{
+			PollID:   created.ID,
+			Position: int32(i),
+			Label:    option,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readPollEntities loads the polls attached to chirps along with their vote
+// counts and the viewer's own votes, keyed by chirp ID.
+func (cfg *ApiConfig) readPollEntities(ctx context.Context, chirpIDs []uuid.UUID, viewerID uuid.NullUUID) (map[uuid.UUID]*pollEntity, error) {
+	polls, err := cfg.DbQueries.ReadPollsByChirpIDs(ctx, chirpIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(polls) == 0 {
+		return map[uuid.UUID]*pollEntity{}, nil
+	}
+
+	pollIDs := make([]uuid.UUID, len(polls))
+	for i, poll := range polls {
+		pollIDs[i] = poll.ID
+	}
+
+	options, err := cfg.DbQueries.ReadPollOptionsByPollIDs(ctx, pollIDs)
+	if err != nil {
+		return nil, err
+	}
+	pollOptions := map[uuid.UUID][]database.ReadPollOptionsByPollIDsRow{}
+	for _, option := range options {
+		pollOptions[option.PollID] = append(pollOptions[option.PollID], option)
+	}
+
+	viewerVotes := map[uuid.UUID]uuid.UUID{}
+	if viewerID.Valid {
+		votes, err := cfg.DbQueries.ReadPollVotesByUser(ctx, database.ReadPollVotesByUserParams{
+			PollIds: pollIDs,
+			UserID:  viewerID.UUID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, vote := range votes {
+			viewerVotes[vote.PollID] = vote.OptionID
+		}
+	}
+
+	now := time.Now().UTC()
+	result := map[uuid.UUID]*pollEntity{}
+	for _, poll := range polls {
+		entity := &pollEntity{
+			ID:                poll.ID,
+			ClosesAt:          poll.ClosesAt,
+			Closed:            !poll.ClosesAt.After(now),
+			ResultsVisibility: poll.ResultsVisibility,
+			Options:           []pollOptionEntity{},
+		}
+		vote, voted := viewerVotes[poll.ID]
+		if voted {
+			entity.ViewerVote = &vote
+		}
+
+		showResults := entity.Closed ||
+			poll.ResultsVisibility == pollResultsAlways ||
+			(poll.ResultsVisibility == pollResultsAfterVote && voted)
+
+		var total int64
+		for _, option := range pollOptions[poll.ID] {
+			optionEntity := pollOptionEntity{ID: option.ID, Label: option.Label}
+			if showResults {
+				votes := option.Votes
+				optionEntity.Votes = &votes
+			}
+			total += option.Votes
+			entity.Options = append(entity.Options, optionEntity)
+		}
+		if showResults {
+			entity.TotalVotes = &total
+		}
+
+		result[poll.ChirpID] = entity
+	}
+
+	return result, nil
+}
+
+// pollOpensAt is when a new chirp's poll starts accepting votes.
+func pollOpensAt(publishAt sql.NullTime) time.Time {
+	if publishAt.Valid {
+		return publishAt.Time
+	}
+	return time.Now().UTC()
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/chirptext"
+	"Chirpy/internal/database"
+)
+
+const (
+	maxDisplayNameChars = 50
+	maxBioChars         = 160
+	maxLocationChars    = 30
+	maxWebsiteChars     = 100
+)
+
+type avatarEntity struct {
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+// authorSummary is the part of a profile embedded in each chirp.
+type authorSummary struct {
+	ID          uuid.UUID     `json:"id"`
+	Handle      string        `json:"handle"`
+	DisplayName string        `json:"display_name"`
+	Avatar      *avatarEntity `json:"avatar"`
+}
+
+// profileResponse is what anyone may see about a user; it must never carry
+// the email address or anything else from the account settings.
+type profileResponse struct {
+	ID             uuid.UUID     `json:"id"`
+	CreatedAt      time.Time     `json:"created_at"`
+	Handle         string        `json:"handle"`
+	DisplayName    string        `json:"display_name"`
+	Bio            string        `json:"bio"`
+	Location       string        `json:"location"`
+	Website        string        `json:"website"`
+	Avatar         *avatarEntity `json:"avatar"`
+	IsChirpyRed    bool          `json:"is_chirpy_red"`
+	Protected      bool          `json:"protected"`
+	FollowerCount  int64         `json:"follower_count"`
+	FollowingCount int64         `json:"following_count"`
+}
+
+type profileParameters struct {
+	DisplayName   string     `json:"display_name"`
+	Bio           string     `json:"bio"`
+	Location      string     `json:"location"`
+	Website       string     `json:"website"`
+	AvatarMediaID *uuid.UUID `json:"avatar_media_id"`
+}
+
+// validate normalizes the profile fields. Only the bio may span several
+// lines, and the website must be an http or https URL.
+func (params *profileParameters) validate() error {
+	fields := []struct {
+		name      string
+		value     *string
+		maxChars  int
+		multiline bool
+	}{
+		{"display_name", &params.DisplayName, maxDisplayNameChars, false},
+		{"bio", &params.Bio, maxBioChars, true},
+		{"location", &params.Location, maxLocationChars, false},
+		{"website", &params.Website, maxWebsiteChars, false},
+	}
+
+	for _, field := range fields {
+		value, err := chirptext.Normalize(strings.TrimSpace(*field.value))
+		if err != nil || (!field.multiline && strings.Contains(value, "\n")) {
+			return fmt.Errorf("%s contains control characters", field.name)
+		}
+		if utf8.RuneCountInString(value) > field.maxChars {
+			return fmt.Errorf("%s must be %d characters or fewer", field.name, field.maxChars)
+		}
+		*field.value = value
+	}
+
+	if params.Website != "" {
+		website, err := url.Parse(params.Website)
+		if err != nil || (website.Scheme != "http" && website.Scheme != "https") || website.Host == "" {
+			return fmt.Errorf("website must be an http or https URL")
+		}
+	}
+	return nil
+}
+
+func (cfg *ApiConfig) newProfileResponse(profile database.ReadUserProfileRow) profileResponse {
+	return profileResponse{
+		ID:             profile.ID,
+		CreatedAt:      profile.CreatedAt,
+		Handle:         profile.Handle.String,
+		DisplayName:    profile.DisplayName,
+		Bio:            profile.Bio,
+		Location:       profile.Location,
+		Website:        profile.Website,
+		Avatar:         cfg.avatarEntity(profile.AvatarBlobKey, profile.AvatarThumbnailKey),
+		IsChirpyRed:    profile.IsChirpyRed,
+		Protected:      profile.Protected,
+		FollowerCount:  profile.FollowerCount,
+		FollowingCount: profile.FollowingCount,
+	}
+}
+
+func (cfg *ApiConfig) avatarEntity(blobKey, thumbnailKey sql.NullString) *avatarEntity {
+	if !blobKey.Valid {
+		return nil
+	}
+	return &avatarEntity{
+		URL:          cfg.BlobStore.URL(blobKey.String),
+		ThumbnailURL: cfg.BlobStore.URL(thumbnailKey.String),
+	}
+}
+
+// readAuthorSummaries loads the profile summaries of several users in one
+// query, keyed by user.
+func (cfg *ApiConfig) readAuthorSummaries(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]*authorSummary, error) {
+	rows, err := cfg.DbQueries.ReadAuthorSummaries(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	authors := map[uuid.UUID]*authorSummary{}
+	for _, row := range rows {
+		authors[row.ID] = &authorSummary{
+			ID:          row.ID,
+			Handle:      row.Handle.String,
+			DisplayName: row.DisplayName,
+			Avatar:      cfg.avatarEntity(row.AvatarBlobKey, row.AvatarThumbnailKey),
+		}
+	}
+	return authors, nil
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const maxContentWarningChars = 100
+
+// Sensitive content preferences. Anonymous callers are treated as
+// sensitiveContentHide, so sensitive chirps stay out of public listings.
+const (
+	sensitiveContentExpand   = "expand"
+	sensitiveContentCollapse = "collapse"
+	sensitiveContentHide     = "hide"
+)
+
+// parseContentWarning trims an optional content warning. An empty warning is
+// stored as NULL.
+func parseContentWarning(warning string) (sql.NullString, error) {
+	warning = strings.TrimSpace(warning)
+	if warning == "" {
+		return sql.NullString{}, nil
+	}
+	if utf8.RuneCountInString(warning) > maxContentWarningChars {
+		return sql.NullString{}, errors.New("content_warning must be 100 characters or fewer")
+	}
+	return sql.NullString{String: warning, Valid: true}, nil
+}
+
+// parseSensitiveContentPreference validates an optional preference update.
+// An empty value leaves the current preference unchanged.
+func parseSensitiveContentPreference(preference string) (sql.NullString, error) {
+	switch preference {
+	case "":
+		return sql.NullString{}, nil
+	case sensitiveContentExpand, sensitiveContentCollapse, sensitiveContentHide:
+		return sql.NullString{String: preference, Valid: true}, nil
+	default:
+		return sql.NullString{}, errors.New("sensitive_content must be expand, collapse or hide")
+	}
+}
+
+func (cfg *ApiConfig) sensitiveContentPreference(ctx context.Context, viewerID uuid.NullUUID) (string, error) {
+	if !viewerID.Valid {
+		return sensitiveContentHide, nil
+	}
+	return cfg.DbQueries.ReadSensitiveContentPreference(ctx, viewerID.UUID)
+}
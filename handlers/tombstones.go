@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+)
+
+const (
+	deletionReasonOwner     = "owner"
+	deletionReasonModerator = "moderator"
+)
+
+type chirpTombstone struct {
+	ID             uuid.UUID `json:"id"`
+	DeletedAt      time.Time `json:"deleted_at"`
+	DeletionReason string    `json:"deletion_reason"`
+}
+
+// softDeleteChirp turns a chirp into a tombstone. Bookmarks and pins pointing
+// at it are kept, hidden by the read queries, so restoring the chirp brings
+// them back; they go with the chirp when it is purged. It reports false when
+// there was no live chirp to delete.
+func (cfg *ApiConfig) softDeleteChirp(ctx context.Context, chirpID uuid.UUID, reason string) (bool, error) {
+	deleted, err := cfg.DbQueries.DeleteChirps(ctx, database.DeleteChirpsParams{
+		ID:             chirpID,
+		DeletionReason: sql.NullString{String: reason, Valid: true},
+	})
+	if err != nil {
+		return false, err
+	}
+	return deleted > 0, nil
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"Chirpy/internal/database"
+	"Chirpy/internal/entities"
+)
+
+// trendSampleChirps is how many recent chirps are shown with each trend.
+const trendSampleChirps = 3
+
+// maxFlagReasonChars limits the note moderators leave when flagging a
+// hashtag.
+const maxFlagReasonChars = 200
+
+type trendResponse struct {
+	Tag           string          `json:"tag"`
+	Score         float64         `json:"score"`
+	ChirpCount    int64           `json:"chirp_count"`
+	AuthorCount   int64           `json:"author_count"`
+	BaselineCount int64           `json:"baseline_count"`
+	SampleChirps  []chirpResponse `json:"sample_chirps"`
+}
+
+type trendsResponse struct {
+	ComputedAt  *time.Time      `json:"computed_at"`
+	WindowStart *time.Time      `json:"window_start"`
+	Trends      []trendResponse `json:"trends"`
+}
+
+func newTrendResponse(trend database.Trend) trendResponse {
+	return trendResponse{
+		Tag:           trend.Tag,
+		Score:         trend.Score,
+		ChirpCount:    trend.ChirpCount,
+		AuthorCount:   trend.AuthorCount,
+		BaselineCount: trend.BaselineCount,
+		SampleChirps:  []chirpResponse{},
+	}
+}
+
+// hashtagFromPath reads the {tag} path value, with or without its leading
+// '#', in the lowercase form hashtags are stored in.
+func hashtagFromPath(r *http.Request) (string, error) {
+	tag := entities.NormalizeHashtag(r.PathValue("tag"))
+	if !entities.ValidHashtag(tag) {
+		return "", errors.New("Invalid hashtag")
+	}
+	return tag, nil
+}
@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/auth"
+)
+
+// viewerID returns the authenticated user for endpoints that are public but
+// tailor their response to whoever is signed in. A missing or invalid token
+// is treated as an anonymous viewer rather than an error.
+func (cfg *ApiConfig) viewerID(r *http.Request) uuid.NullUUID {
+	token, err := auth.GetBearerToken(r.Header)
+	if err != nil {
+		return uuid.NullUUID{}
+	}
+
+	userID, err := auth.ValidateJWT(token, cfg.JwtSecret)
+	if err != nil {
+		return uuid.NullUUID{}
+	}
+
+	return uuid.NullUUID{UUID: userID, Valid: true}
+}
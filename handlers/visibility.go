@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+)
+
+const (
+	visibilityPublic    = "public"
+	visibilityFollowers = "followers"
+	visibilityUnlisted  = "unlisted"
+)
+
+// parseVisibility validates the visibility requested for a new chirp,
+// defaulting to public.
+func parseVisibility(visibility string) (string, error) {
+	switch visibility {
+	case "":
+		return visibilityPublic, nil
+	case visibilityPublic, visibilityFollowers, visibilityUnlisted:
+		return visibility, nil
+	default:
+		return "", errors.New("visibility must be public, followers or unlisted")
+	}
+}
+
+// readVisibleChirp loads a chirp by ID on behalf of viewerID. The visibility
+// rules are applied by the database; a chirp the viewer may not see is
+// reported as sql.ErrNoRows so it is indistinguishable from a missing one.
+func readVisibleChirp(ctx context.Context, q *database.Queries, chirpID uuid.UUID, viewerID uuid.NullUUID) (database.Chirp, error) {
+	row, err := q.ReadChirpsByID(ctx, database.ReadChirpsByIDParams{
+		ViewerID: viewerID,
+		ID:       chirpID,
+	})
+	if err != nil {
+		return database.Chirp{}, err
+	}
+	if !row.Visible {
+		return database.Chirp{}, sql.ErrNoRows
+	}
+	return row.Chirp, nil
+}
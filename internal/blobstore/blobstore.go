@@ -0,0 +1,19 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+// BlobStore keeps uploaded files. Keys are opaque slash-separated paths chosen
+// by the caller; implementations decide where the bytes actually live.
+type BlobStore interface {
+	Put(ctx context.Context, key string, contentType string, data io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	// URL returns where clients can download the blob.
+	URL(key string) string
+}
@@ -0,0 +1,58 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestLocalStore_RoundTrip(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir(), "/media/")
+	if err != nil {
+		t.Fatalf("NewLocalStore failed: %v", err)
+	}
+	ctx := context.Background()
+
+	err = store.Put(ctx, "images/abc.png", "image/png", strings.NewReader("png bytes"))
+	if err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+
+	blob, err := store.Get(ctx, "images/abc.png")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	data, err := io.ReadAll(blob)
+	blob.Close()
+	if err != nil || string(data) != "png bytes" {
+		t.Errorf("Expected stored bytes back, got %q (%v)", data, err)
+	}
+
+	if url := store.URL("images/abc.png"); url != "/media/images/abc.png" {
+		t.Errorf("Unexpected URL %s", url)
+	}
+
+	err = store.Delete(ctx, "images/abc.png")
+	if err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	_, err = store.Get(ctx, "images/abc.png")
+	if err != ErrNotFound {
+		t.Errorf("Expected ErrNotFound after delete, got %v", err)
+	}
+}
+
+func TestLocalStore_RejectsEscapingKeys(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir(), "/media")
+	if err != nil {
+		t.Fatalf("NewLocalStore failed: %v", err)
+	}
+
+	for _, key := range []string{"../secret", "a/../../b", "", "/abs"} {
+		err := store.Put(context.Background(), key, "text/plain", strings.NewReader("x"))
+		if err == nil {
+			t.Errorf("Expected error for key %q", key)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package chirptext
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+
+	"github.com/rivo/uniseg"
+	"golang.org/x/text/unicode/norm"
+
+	"Chirpy/internal/entities"
+)
+
+// URLWeight is how many characters a URL counts for, however long it is.
+const URLWeight = 23
+
+var ErrControlCharacter = errors.New("Chirp contains control characters")
+
+// Normalize converts body to NFC so that visually identical chirps are
+// stored, searched and counted the same way. Line breaks are normalized to
+// \n and any other control character is rejected.
+func Normalize(body string) (string, error) {
+	body = strings.ReplaceAll(body, "\r\n", "\n")
+	for _, r := range body {
+		if r != '\n' && unicode.IsControl(r) {
+			return "", ErrControlCharacter
+		}
+	}
+	return norm.NFC.String(body), nil
+}
+
+// Length counts body the way users see it: one per grapheme cluster, so an
+// emoji made of several code points counts once, with every URL counting
+// URLWeight regardless of its length.
+func Length(body string) int {
+	runes := []rune(body)
+	length := 0
+	prev := 0
+	for _, url := range entities.ParseURLs(body) {
+		length += uniseg.GraphemeClusterCount(string(runes[prev:url.Start])) + URLWeight
+		prev = url.End
+	}
+	return length + uniseg.GraphemeClusterCount(string(runes[prev:]))
+}
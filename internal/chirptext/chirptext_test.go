@@ -0,0 +1,106 @@
+package chirptext
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "plain text is unchanged",
+			body:     "hello world",
+			expected: "hello world",
+		},
+		{
+			name:     "decomposed accents are composed",
+			body:     "cafe\u0301",
+			expected: "caf\u00e9",
+		},
+		{
+			name:     "crlf becomes lf",
+			body:     "one\r\ntwo",
+			expected: "one\ntwo",
+		},
+		{
+			name:        "null byte is rejected",
+			body:        "hi\x00there",
+			expectError: true,
+		},
+		{
+			name:        "bell is rejected",
+			body:        "ding\a",
+			expectError: true,
+		},
+		{
+			name:        "lone carriage return is rejected",
+			body:        "one\rtwo",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Normalize(tt.body)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("Normalize(%q) error = %v, expectError %v", tt.body, err, tt.expectError)
+			}
+			if got != tt.expected {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.body, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLength(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected int
+	}{
+		{
+			name:     "ascii",
+			body:     "hello",
+			expected: 5,
+		},
+		{
+			name:     "combining accents count once",
+			body:     "cafe\u0301",
+			expected: 4,
+		},
+		{
+			name:     "emoji with modifiers count once",
+			body:     "👍🏽👨‍👩‍👧",
+			expected: 2,
+		},
+		{
+			name:     "flags count once",
+			body:     "🇳🇿",
+			expected: 1,
+		},
+		{
+			name:     "urls have a fixed weight",
+			body:     "see https://example.com/a/very/long/path/that/goes/on/and/on",
+			expected: 4 + URLWeight,
+		},
+		{
+			name:     "140 emoji fit",
+			body:     strings.Repeat("🎉", 140),
+			expected: 140,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Length(tt.body)
+			if got != tt.expected {
+				t.Errorf("Length(%q) = %d, want %d", tt.body, got, tt.expected)
+			}
+		})
+	}
+}
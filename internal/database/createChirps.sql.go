@@ -7,29 +7,61 @@ package database
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/google/uuid"
 )
 
 const createChirp = `-- name: CreateChirp :one
-INSERT INTO chirps (id, created_at, updated_at, body, user_id)
-VALUES (
-    gen_random_uuid(),
-    Now(),
-    Now(),
-    $1,
-    $2
+WITH created AS (
+    INSERT INTO chirps (id, created_at, updated_at, body, user_id, publish_at, published, visibility, content_warning, sensitive, reply_to_id)
+    VALUES (
+        gen_random_uuid(),
+        Now(),
+        Now(),
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8
+    )
+    RETURNING id, created_at, updated_at, body, user_id, publish_at, published, deleted_at, deletion_reason, visibility, content_warning, sensitive, reply_to_id, reply_count, like_count, reply_notified_at, fanned_out
+), replied AS (
+    UPDATE chirps
+    SET reply_count = reply_count + 1
+    FROM created
+    WHERE chirps.id = created.reply_to_id
+        AND created.published
 )
-RETURNING id, created_at, updated_at, body, user_id
+SELECT id, created_at, updated_at, body, user_id, publish_at, published, deleted_at, deletion_reason, visibility, content_warning, sensitive, reply_to_id, reply_count, like_count, reply_notified_at, fanned_out
+FROM created
 `
 
 type CreateChirpParams struct {
-	Body   string        `json:"body"`
-	UserID uuid.NullUUID `json:"user_id"`
+	Body           string         `json:"body"`
+	UserID         uuid.NullUUID  `json:"user_id"`
+	PublishAt      sql.NullTime   `json:"publish_at"`
+	Published      bool           `json:"published"`
+	Visibility     string         `json:"visibility"`
+	ContentWarning sql.NullString `json:"content_warning"`
+	Sensitive      bool           `json:"sensitive"`
+	ReplyToID      uuid.NullUUID  `json:"reply_to_id"`
 }
 
 func (q *Queries) CreateChirp(ctx context.Context, arg CreateChirpParams) (Chirp, error) {
-	row := q.db.QueryRowContext(ctx, createChirp, arg.Body, arg.UserID)
+	row := q.db.QueryRowContext(ctx, createChirp,
+		arg.Body,
+		arg.UserID,
+		arg.PublishAt,
+		arg.Published,
+		arg.Visibility,
+		arg.ContentWarning,
+		arg.Sensitive,
+		arg.ReplyToID,
+	)
 	var i Chirp
 	err := row.Scan(
 		&i.ID,
@@ -37,6 +69,18 @@ func (q *Queries) CreateChirp(ctx context.Context, arg CreateChirpParams) (Chirp
 		&i.UpdatedAt,
 		&i.Body,
 		&i.UserID,
+		&i.PublishAt,
+		&i.Published,
+		&i.DeletedAt,
+		&i.DeletionReason,
+		&i.Visibility,
+		&i.ContentWarning,
+		&i.Sensitive,
+		&i.ReplyToID,
+		&i.ReplyCount,
+		&i.LikeCount,
+		&i.ReplyNotifiedAt,
+		&i.FannedOut,
 	)
 	return i, err
 }
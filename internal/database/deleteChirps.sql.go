@@ -7,16 +7,40 @@ package database
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/google/uuid"
 )
 
-const deleteChirps = `-- name: DeleteChirps :exec
-DELETE FROM chirps
-WHERE id = $1
+const deleteChirps = `-- name: DeleteChirps :execrows
+WITH target AS (
+    SELECT id, reply_to_id
+    FROM chirps
+    WHERE chirps.id = $1
+        AND published
+        AND deleted_at IS NULL
+    FOR UPDATE
+), replied AS (
+    UPDATE chirps
+    SET reply_count = reply_count - 1
+    FROM target
+    WHERE chirps.id = target.reply_to_id
+)
+UPDATE chirps
+SET deleted_at = NOW(), deletion_reason = $2, updated_at = NOW()
+FROM target
+WHERE chirps.id = target.id
 `
 
-func (q *Queries) DeleteChirps(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, deleteChirps, id)
-	return err
+type DeleteChirpsParams struct {
+	ID             uuid.UUID      `json:"id"`
+	DeletionReason sql.NullString `json:"deletion_reason"`
+}
+
+func (q *Queries) DeleteChirps(ctx context.Context, arg DeleteChirpsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteChirps, arg.ID, arg.DeletionReason)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
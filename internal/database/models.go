@@ -11,12 +11,231 @@ import (
 	"github.com/google/uuid"
 )
 
+type BlobDeletion struct {
+	BlobKey   string    `json:"blob_key"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Block struct {
+	BlockerID uuid.UUID `json:"blocker_id"`
+	BlockedID uuid.UUID `json:"blocked_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Bookmark struct {
+	UserID    uuid.UUID `json:"user_id"`
+	ChirpID   uuid.UUID `json:"chirp_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 type Chirp struct {
+	ID              uuid.UUID      `json:"id"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	Body            string         `json:"body"`
+	UserID          uuid.NullUUID  `json:"user_id"`
+	PublishAt       sql.NullTime   `json:"publish_at"`
+	Published       bool           `json:"published"`
+	DeletedAt       sql.NullTime   `json:"deleted_at"`
+	DeletionReason  sql.NullString `json:"deletion_reason"`
+	Visibility      string         `json:"visibility"`
+	ContentWarning  sql.NullString `json:"content_warning"`
+	Sensitive       bool           `json:"sensitive"`
+	ReplyToID       uuid.NullUUID  `json:"reply_to_id"`
+	ReplyCount      int32          `json:"reply_count"`
+	LikeCount       int32          `json:"like_count"`
+	ReplyNotifiedAt sql.NullTime   `json:"reply_notified_at"`
+	FannedOut       bool           `json:"fanned_out"`
+}
+
+type ChirpHashtag struct {
+	ChirpID   uuid.UUID `json:"chirp_id"`
+	Tag       string    `json:"tag"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ChirpLink struct {
+	ChirpID uuid.UUID `json:"chirp_id"`
+	LinkID  uuid.UUID `json:"link_id"`
+}
+
+type ChirpMention struct {
+	ChirpID    uuid.UUID    `json:"chirp_id"`
+	UserID     uuid.UUID    `json:"user_id"`
+	Handle     string       `json:"handle"`
+	CreatedAt  time.Time    `json:"created_at"`
+	NotifiedAt sql.NullTime `json:"notified_at"`
+}
+
+type Conversation struct {
+	ID              uuid.UUID     `json:"id"`
+	CreatedAt       time.Time     `json:"created_at"`
+	UpdatedAt       time.Time     `json:"updated_at"`
+	CreatedBy       uuid.NullUUID `json:"created_by"`
+	FilterProfanity bool          `json:"filter_profanity"`
+	DirectUserA     uuid.NullUUID `json:"direct_user_a"`
+	DirectUserB     uuid.NullUUID `json:"direct_user_b"`
+}
+
+type ConversationParticipant struct {
+	ConversationID uuid.UUID    `json:"conversation_id"`
+	UserID         uuid.UUID    `json:"user_id"`
+	JoinedAt       time.Time    `json:"joined_at"`
+	LastReadAt     sql.NullTime `json:"last_read_at"`
+}
+
+type Draft struct {
+	ID             uuid.UUID      `json:"id"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+	Body           string         `json:"body"`
+	UserID         uuid.UUID      `json:"user_id"`
+	Visibility     string         `json:"visibility"`
+	ContentWarning sql.NullString `json:"content_warning"`
+	Sensitive      bool           `json:"sensitive"`
+}
+
+type FlaggedHashtag struct {
+	Tag       string    `json:"tag"`
+	CreatedAt time.Time `json:"created_at"`
+	Reason    string    `json:"reason"`
+}
+
+type Follow struct {
+	FollowerID uuid.UUID `json:"follower_id"`
+	FolloweeID uuid.UUID `json:"followee_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type FollowRequest struct {
+	RequesterID uuid.UUID `json:"requester_id"`
+	TargetID    uuid.UUID `json:"target_id"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type FollowSuggestion struct {
+	UserID             uuid.UUID `json:"user_id"`
+	SuggestedID        uuid.UUID `json:"suggested_id"`
+	Score              float64   `json:"score"`
+	MutualCount        int64     `json:"mutual_count"`
+	SharedHashtagCount int64     `json:"shared_hashtag_count"`
+	RecentChirpCount   int64     `json:"recent_chirp_count"`
+}
+
+type FollowSuggestionRefresh struct {
+	UserID      uuid.UUID `json:"user_id"`
+	RefreshedAt time.Time `json:"refreshed_at"`
+}
+
+type Like struct {
+	UserID    uuid.UUID `json:"user_id"`
+	ChirpID   uuid.UUID `json:"chirp_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Link struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	Code      string    `json:"code"`
+	Url       string    `json:"url"`
+	Clicks    int64     `json:"clicks"`
+}
+
+type List struct {
+	ID          uuid.UUID `json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	UserID      uuid.UUID `json:"user_id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Visibility  string    `json:"visibility"`
+}
+
+type ListMember struct {
+	ListID    uuid.UUID `json:"list_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type MediaAttachment struct {
+	ID           uuid.UUID     `json:"id"`
+	CreatedAt    time.Time     `json:"created_at"`
+	UserID       uuid.UUID     `json:"user_id"`
+	ChirpID      uuid.NullUUID `json:"chirp_id"`
+	Position     sql.NullInt32 `json:"position"`
+	ContentType  string        `json:"content_type"`
+	Width        int32         `json:"width"`
+	Height       int32         `json:"height"`
+	SizeBytes    int32         `json:"size_bytes"`
+	BlobKey      string        `json:"blob_key"`
+	ThumbnailKey string        `json:"thumbnail_key"`
+}
+
+type Message struct {
+	ID             uuid.UUID     `json:"id"`
+	ConversationID uuid.UUID     `json:"conversation_id"`
+	SenderID       uuid.NullUUID `json:"sender_id"`
+	Body           string        `json:"body"`
+	CreatedAt      time.Time     `json:"created_at"`
+}
+
+type Mute struct {
+	MuterID   uuid.UUID `json:"muter_id"`
+	MutedID   uuid.UUID `json:"muted_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type MutedKeyword struct {
+	ID        uuid.UUID    `json:"id"`
+	UserID    uuid.UUID    `json:"user_id"`
+	Keyword   string       `json:"keyword"`
+	CreatedAt time.Time    `json:"created_at"`
+	ExpiresAt sql.NullTime `json:"expires_at"`
+}
+
+type Notification struct {
 	ID        uuid.UUID     `json:"id"`
+	UserID    uuid.UUID     `json:"user_id"`
+	Type      string        `json:"type"`
+	ChirpID   uuid.NullUUID `json:"chirp_id"`
 	CreatedAt time.Time     `json:"created_at"`
 	UpdatedAt time.Time     `json:"updated_at"`
-	Body      string        `json:"body"`
-	UserID    uuid.NullUUID `json:"user_id"`
+	ReadAt    sql.NullTime  `json:"read_at"`
+}
+
+type NotificationActor struct {
+	NotificationID uuid.UUID `json:"notification_id"`
+	ActorID        uuid.UUID `json:"actor_id"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type PinnedChirp struct {
+	UserID    uuid.UUID `json:"user_id"`
+	ChirpID   uuid.UUID `json:"chirp_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Poll struct {
+	ID                uuid.UUID `json:"id"`
+	CreatedAt         time.Time `json:"created_at"`
+	ChirpID           uuid.UUID `json:"chirp_id"`
+	ClosesAt          time.Time `json:"closes_at"`
+	ResultsVisibility string    `json:"results_visibility"`
+}
+
+type PollOption struct {
+	ID       uuid.UUID `json:"id"`
+	PollID   uuid.UUID `json:"poll_id"`
+	Position int32     `json:"position"`
+	Label    string    `json:"label"`
+}
+
+type PollVote struct {
+	PollID    uuid.UUID `json:"poll_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	OptionID  uuid.UUID `json:"option_id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 type RefreshToken struct {
@@ -28,11 +247,36 @@ type RefreshToken struct {
 	RevokedAt sql.NullTime `json:"revoked_at"`
 }
 
+type Trend struct {
+	SnapshotID    uuid.UUID `json:"snapshot_id"`
+	Tag           string    `json:"tag"`
+	Position      int32     `json:"position"`
+	Score         float64   `json:"score"`
+	ChirpCount    int64     `json:"chirp_count"`
+	AuthorCount   int64     `json:"author_count"`
+	BaselineCount int64     `json:"baseline_count"`
+}
+
+type TrendSnapshot struct {
+	ID          uuid.UUID `json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	WindowStart time.Time `json:"window_start"`
+}
+
 type User struct {
-	ID             uuid.UUID `json:"id"`
-	CreatedAt      time.Time `json:"created_at"`
-	UpdatedAt      time.Time `json:"updated_at"`
-	Email          string    `json:"email"`
-	HashedPassword string    `json:"hashed_password"`
-	IsChirpyRed    bool      `json:"is_chirpy_red"`
+	ID               uuid.UUID      `json:"id"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	Email            string         `json:"email"`
+	HashedPassword   string         `json:"hashed_password"`
+	IsChirpyRed      bool           `json:"is_chirpy_red"`
+	Handle           sql.NullString `json:"handle"`
+	SensitiveContent string         `json:"sensitive_content"`
+	FollowerCount    int64          `json:"follower_count"`
+	DisplayName      string         `json:"display_name"`
+	Bio              string         `json:"bio"`
+	Location         string         `json:"location"`
+	Website          string         `json:"website"`
+	AvatarMediaID    uuid.NullUUID  `json:"avatar_media_id"`
+	Protected        bool           `json:"protected"`
 }
@@ -12,20 +12,48 @@ import (
 )
 
 const readChirpsByID = `-- name: ReadChirpsByID :one
-SELECT id, created_at, updated_at, body, user_id
+SELECT
+    chirps.id, chirps.created_at, chirps.updated_at, chirps.body, chirps.user_id, chirps.publish_at, chirps.published, chirps.deleted_at, chirps.deletion_reason, chirps.visibility, chirps.content_warning, chirps.sensitive, chirps.reply_to_id, chirps.reply_count, chirps.like_count, chirps.reply_notified_at, chirps.fanned_out,
+    chirp_visible_to(chirps, $1::uuid, true)::boolean AS visible,
+    chirp_audience_includes(chirps, $1::uuid, true)::boolean AS in_audience
 FROM chirps
-WHERE id = $1
+WHERE id = $2
 `
 
-func (q *Queries) ReadChirpsByID(ctx context.Context, id uuid.UUID) (Chirp, error) {
-	row := q.db.QueryRowContext(ctx, readChirpsByID, id)
-	var i Chirp
+type ReadChirpsByIDParams struct {
+	ViewerID uuid.NullUUID `json:"viewer_id"`
+	ID       uuid.UUID     `json:"id"`
+}
+
+type ReadChirpsByIDRow struct {
+	Chirp      Chirp `json:"chirp"`
+	Visible    bool  `json:"visible"`
+	InAudience bool  `json:"in_audience"`
+}
+
+func (q *Queries) ReadChirpsByID(ctx context.Context, arg ReadChirpsByIDParams) (ReadChirpsByIDRow, error) {
+	row := q.db.QueryRowContext(ctx, readChirpsByID, arg.ViewerID, arg.ID)
+	var i ReadChirpsByIDRow
 	err := row.Scan(
-		&i.ID,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.Body,
-		&i.UserID,
+		&i.Chirp.ID,
+		&i.Chirp.CreatedAt,
+		&i.Chirp.UpdatedAt,
+		&i.Chirp.Body,
+		&i.Chirp.UserID,
+		&i.Chirp.PublishAt,
+		&i.Chirp.Published,
+		&i.Chirp.DeletedAt,
+		&i.Chirp.DeletionReason,
+		&i.Chirp.Visibility,
+		&i.Chirp.ContentWarning,
+		&i.Chirp.Sensitive,
+		&i.Chirp.ReplyToID,
+		&i.Chirp.ReplyCount,
+		&i.Chirp.LikeCount,
+		&i.Chirp.ReplyNotifiedAt,
+		&i.Chirp.FannedOut,
+		&i.Visible,
+		&i.InAudience,
 	)
 	return i, err
 }
@@ -1,7 +1,7 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
 //   sqlc v1.29.0
-// source: readChirpsByAuthor.sql
+// source: readDraftsByUser.sql
 
 package database
 
@@ -11,28 +11,31 @@ import (
 	"github.com/google/uuid"
 )
 
-const readChirpsByAuthor = `-- name: ReadChirpsByAuthor :many
-SELECT id, created_at, updated_at, body, user_id 
-FROM chirps
+const readDraftsByUser = `-- name: ReadDraftsByUser :many
+SELECT id, created_at, updated_at, body, user_id, visibility, content_warning, sensitive
+FROM drafts
 WHERE user_id = $1
-ORDER BY created_at ASC
+ORDER BY updated_at DESC
 `
 
-func (q *Queries) ReadChirpsByAuthor(ctx context.Context, userID uuid.NullUUID) ([]Chirp, error) {
-	rows, err := q.db.QueryContext(ctx, readChirpsByAuthor, userID)
+func (q *Queries) ReadDraftsByUser(ctx context.Context, userID uuid.UUID) ([]Draft, error) {
+	rows, err := q.db.QueryContext(ctx, readDraftsByUser, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Chirp
+	var items []Draft
 	for rows.Next() {
-		var i Chirp
+		var i Draft
 		if err := rows.Scan(
 			&i.ID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Body,
 			&i.UserID,
+			&i.Visibility,
+			&i.ContentWarning,
+			&i.Sensitive,
 		); err != nil {
 			return nil, err
 		}
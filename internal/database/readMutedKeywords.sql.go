@@ -1,7 +1,7 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
 //   sqlc v1.29.0
-// source: readChirpsByAuthorDesc.sql
+// source: readMutedKeywords.sql
 
 package database
 
@@ -11,27 +11,29 @@ import (
 	"github.com/google/uuid"
 )
 
-const readChirpsByAuthorDesc = `-- name: ReadChirpsByAuthorDesc :many
-SELECT id, created_at, updated_at, body, user_id FROM chirps
+const readMutedKeywords = `-- name: ReadMutedKeywords :many
+SELECT id, user_id, keyword, created_at, expires_at
+FROM muted_keywords
 WHERE user_id = $1
+    AND (expires_at IS NULL OR expires_at > NOW())
 ORDER BY created_at DESC
 `
 
-func (q *Queries) ReadChirpsByAuthorDesc(ctx context.Context, userID uuid.NullUUID) ([]Chirp, error) {
-	rows, err := q.db.QueryContext(ctx, readChirpsByAuthorDesc, userID)
+func (q *Queries) ReadMutedKeywords(ctx context.Context, userID uuid.UUID) ([]MutedKeyword, error) {
+	rows, err := q.db.QueryContext(ctx, readMutedKeywords, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Chirp
+	var items []MutedKeyword
 	for rows.Next() {
-		var i Chirp
+		var i MutedKeyword
 		if err := rows.Scan(
 			&i.ID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.Body,
 			&i.UserID,
+			&i.Keyword,
+			&i.CreatedAt,
+			&i.ExpiresAt,
 		); err != nil {
 			return nil, err
 		}
@@ -10,7 +10,7 @@ import (
 )
 
 const readUserByEmail = `-- name: ReadUserByEmail :one
-SELECT id, created_at, updated_at, email, hashed_password, is_chirpy_red
+SELECT id, created_at, updated_at, email, hashed_password, is_chirpy_red, handle, sensitive_content, follower_count, display_name, bio, location, website, avatar_media_id, protected
 FROM users
 WHERE email = $1
 `
@@ -25,6 +25,15 @@ func (q *Queries) ReadUserByEmail(ctx context.Context, email string) (User, erro
 		&i.Email,
 		&i.HashedPassword,
 		&i.IsChirpyRed,
+		&i.Handle,
+		&i.SensitiveContent,
+		&i.FollowerCount,
+		&i.DisplayName,
+		&i.Bio,
+		&i.Location,
+		&i.Website,
+		&i.AvatarMediaID,
+		&i.Protected,
 	)
 	return i, err
 }
@@ -15,7 +15,7 @@ const updateUserToChirpyRed = `-- name: UpdateUserToChirpyRed :one
 UPDATE users
 SET is_chirpy_red = true, updated_at = NOW()
 WHERE id = $1
-RETURNING id, created_at, updated_at, email, hashed_password, is_chirpy_red
+RETURNING id, created_at, updated_at, email, hashed_password, is_chirpy_red, handle, sensitive_content, follower_count, display_name, bio, location, website, avatar_media_id, protected
 `
 
 func (q *Queries) UpdateUserToChirpyRed(ctx context.Context, id uuid.UUID) (User, error) {
@@ -28,6 +28,15 @@ func (q *Queries) UpdateUserToChirpyRed(ctx context.Context, id uuid.UUID) (User
 		&i.Email,
 		&i.HashedPassword,
 		&i.IsChirpyRed,
+		&i.Handle,
+		&i.SensitiveContent,
+		&i.FollowerCount,
+		&i.DisplayName,
+		&i.Bio,
+		&i.Location,
+		&i.Website,
+		&i.AvatarMediaID,
+		&i.Protected,
 	)
 	return i, err
 }
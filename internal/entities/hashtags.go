@@ -0,0 +1,76 @@
+package entities
+
+import "strings"
+
+const MaxHashtagLength = 50
+
+type Hashtag struct {
+	Tag   string
+	Start int
+	End   int
+}
+
+// ParseHashtags finds every #tag in body. Start and End are code point
+// offsets into body covering the leading '#', and tags are lowercased. Tags
+// made up only of digits are ignored.
+func ParseHashtags(body string) []Hashtag {
+	hashtags := []Hashtag{}
+	runes := []rune(body)
+
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '#' {
+			continue
+		}
+		if i > 0 && (isHandleRune(runes[i-1]) || runes[i-1] == '&') {
+			continue
+		}
+
+		end := i + 1
+		hasLetter := false
+		for end < len(runes) && isHandleRune(runes[end]) {
+			if runes[end] < '0' || runes[end] > '9' {
+				hasLetter = true
+			}
+			end++
+		}
+
+		length := end - (i + 1)
+		if length == 0 || length > MaxHashtagLength || !hasLetter {
+			i = end - 1
+			continue
+		}
+
+		hashtags = append(hashtags, Hashtag{
+			Tag:   strings.ToLower(string(runes[i+1 : end])),
+			Start: i,
+			End:   end,
+		})
+		i = end - 1
+	}
+
+	return hashtags
+}
+
+// HashtagsIn returns the distinct tags used in body in the order they first
+// appear.
+func HashtagsIn(body string) []string {
+	seen := map[string]bool{}
+	tags := []string{}
+	for _, hashtag := range ParseHashtags(body) {
+		if seen[hashtag.Tag] {
+			continue
+		}
+		seen[hashtag.Tag] = true
+		tags = append(tags, hashtag.Tag)
+	}
+	return tags
+}
+
+func NormalizeHashtag(tag string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+}
+
+func ValidHashtag(tag string) bool {
+	hashtags := ParseHashtags("#" + tag)
+	return len(hashtags) == 1 && hashtags[0].End == len([]rune(tag))+1
+}
@@ -0,0 +1,66 @@
+package entities
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseHashtags(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected []Hashtag
+	}{
+		{
+			name:     "no hashtags",
+			body:     "nothing to see here",
+			expected: []Hashtag{},
+		},
+		{
+			name: "hashtags are lowercased",
+			body: "learning #Go with #boot_dev",
+			expected: []Hashtag{
+				{Tag: "go", Start: 9, End: 12},
+				{Tag: "boot_dev", Start: 18, End: 27},
+			},
+		},
+		{
+			name:     "numbers only are ignored",
+			body:     "we are #1",
+			expected: []Hashtag{},
+		},
+		{
+			name:     "html entities and anchors are ignored",
+			body:     "see page#section and &#39;",
+			expected: []Hashtag{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hashtags := ParseHashtags(tt.body)
+			if !reflect.DeepEqual(hashtags, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, hashtags)
+			}
+		})
+	}
+}
+
+func TestValidHashtag(t *testing.T) {
+	tests := []struct {
+		tag      string
+		expected bool
+	}{
+		{"golang", true},
+		{"go2", true},
+		{"2024", false},
+		{"two words", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if ValidHashtag(tt.tag) != tt.expected {
+			t.Errorf("ValidHashtag(%q): expected %v", tt.tag, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package entities
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const MaxHandleLength = 15
+
+type Mention struct {
+	Handle string
+	Start  int
+	End    int
+}
+
+// ParseMentions finds every @handle in body. Start and End are code point
+// offsets into body covering the leading '@', and handles are lowercased.
+func ParseMentions(body string) []Mention {
+	mentions := []Mention{}
+	runes := []rune(body)
+
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '@' {
+			continue
+		}
+		if i > 0 && (isHandleRune(runes[i-1]) || runes[i-1] == '@') {
+			continue
+		}
+
+		end := i + 1
+		for end < len(runes) && isHandleRune(runes[end]) {
+			end++
+		}
+
+		length := end - (i + 1)
+		if length == 0 || length > MaxHandleLength {
+			i = end - 1
+			continue
+		}
+		if end < len(runes) && runes[end] == '@' {
+			i = end - 1
+			continue
+		}
+
+		mentions = append(mentions, Mention{
+			Handle: strings.ToLower(string(runes[i+1 : end])),
+			Start:  i,
+			End:    end,
+		})
+		i = end - 1
+	}
+
+	return mentions
+}
+
+// MentionedHandles returns the distinct handles mentioned in body in the
+// order they first appear.
+func MentionedHandles(body string) []string {
+	seen := map[string]bool{}
+	handles := []string{}
+	for _, mention := range ParseMentions(body) {
+		if seen[mention.Handle] {
+			continue
+		}
+		seen[mention.Handle] = true
+		handles = append(handles, mention.Handle)
+	}
+	return handles
+}
+
+func NormalizeHandle(handle string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(handle), "@"))
+}
+
+func ValidHandle(handle string) bool {
+	if handle == "" || utf8.RuneCountInString(handle) > MaxHandleLength {
+		return false
+	}
+	for _, r := range handle {
+		if !isHandleRune(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func isHandleRune(r rune) bool {
+	return r == '_' ||
+		(r >= 'a' && r <= 'z') ||
+		(r >= 'A' && r <= 'Z') ||
+		(r >= '0' && r <= '9')
+}
@@ -0,0 +1,96 @@
+package entities
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected []Mention
+	}{
+		{
+			name:     "no mentions",
+			body:     "just a regular chirp",
+			expected: []Mention{},
+		},
+		{
+			name: "single mention",
+			body: "hello @Alice!",
+			expected: []Mention{
+				{Handle: "alice", Start: 6, End: 12},
+			},
+		},
+		{
+			name: "mention at start and repeated",
+			body: "@bob and @bob_2 and @bob",
+			expected: []Mention{
+				{Handle: "bob", Start: 0, End: 4},
+				{Handle: "bob_2", Start: 9, End: 15},
+				{Handle: "bob", Start: 20, End: 24},
+			},
+		},
+		{
+			name:     "email address is not a mention",
+			body:     "mail me at alice@example.com",
+			expected: []Mention{},
+		},
+		{
+			name:     "handle too long",
+			body:     "hi @abcdefghijklmnop",
+			expected: []Mention{},
+		},
+		{
+			name:     "bare at sign",
+			body:     "meet @ noon",
+			expected: []Mention{},
+		},
+		{
+			name: "offsets count code points",
+			body: "héllo 🎉 @carol",
+			expected: []Mention{
+				{Handle: "carol", Start: 8, End: 14},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mentions := ParseMentions(tt.body)
+			if !reflect.DeepEqual(mentions, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, mentions)
+			}
+		})
+	}
+}
+
+func TestMentionedHandles(t *testing.T) {
+	handles := MentionedHandles("@Bob hi @alice, @bob again")
+	expected := []string{"bob", "alice"}
+	if !reflect.DeepEqual(handles, expected) {
+		t.Errorf("Expected %v, got %v", expected, handles)
+	}
+}
+
+func TestValidHandle(t *testing.T) {
+	tests := []struct {
+		handle   string
+		expected bool
+	}{
+		{"alice", true},
+		{"Alice_99", true},
+		{"", false},
+		{"has space", false},
+		{"dash-ed", false},
+		{"abcdefghijklmnop", false},
+		{"émile", false},
+	}
+
+	for _, tt := range tests {
+		if ValidHandle(tt.handle) != tt.expected {
+			t.Errorf("ValidHandle(%q): expected %v", tt.handle, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package entities
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+// maxDisplayURLLength is how many code points of a URL are shown before it
+// is cut off with an ellipsis.
+const maxDisplayURLLength = 30
+
+type URL struct {
+	URL   string
+	Start int
+	End   int
+}
+
+// ParseURLs finds every http and https URL in body. Start and End are code
+// point offsets into body. Trailing punctuation and unbalanced closing
+// brackets are left out so "see https://example.com)." yields just the URL.
+func ParseURLs(body string) []URL {
+	urls := []URL{}
+	runes := []rune(body)
+
+	for i := 0; i < len(runes); i++ {
+		scheme := urlScheme(runes[i:])
+		if scheme == 0 {
+			continue
+		}
+		if i > 0 && (unicode.IsLetter(runes[i-1]) || unicode.IsDigit(runes[i-1])) {
+			continue
+		}
+
+		end := i + scheme
+		for end < len(runes) && !unicode.IsSpace(runes[end]) {
+			end++
+		}
+		end = trimURLEnd(runes, i+scheme, end)
+		if end == i+scheme {
+			i = end - 1
+			continue
+		}
+
+		urls = append(urls, URL{
+			URL:   string(runes[i:end]),
+			Start: i,
+			End:   end,
+		})
+		i = end - 1
+	}
+
+	return urls
+}
+
+// urlScheme returns the length of the http:// or https:// prefix at the start
+// of runes, or 0 when there is none.
+func urlScheme(runes []rune) int {
+	for _, scheme := range []string{"https://", "http://"} {
+		n := len(scheme)
+		if len(runes) >= n && strings.EqualFold(string(runes[:n]), scheme) {
+			return n
+		}
+	}
+	return 0
+}
+
+func trimURLEnd(runes []rune, hostStart, end int) int {
+	for end > hostStart {
+		last := runes[end-1]
+		switch {
+		case strings.ContainsRune(".,;:!?'\"", last):
+			end--
+		case last == ')' && !balanced(runes[hostStart:end], '(', ')'):
+			end--
+		case last == ']' && !balanced(runes[hostStart:end], '[', ']'):
+			end--
+		default:
+			return end
+		}
+	}
+	return end
+}
+
+func balanced(runes []rune, open, close rune) bool {
+	depth := 0
+	for _, r := range runes {
+		switch r {
+		case open:
+			depth++
+		case close:
+			depth--
+		}
+	}
+	return depth >= 0
+}
+
+// URLHost returns the lowercased host of rawURL without its port, or "" when
+// it can't be parsed.
+func URLHost(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(strings.ToLower(parsed.Hostname()), ".")
+}
+
+// HostInDomain reports whether host is domain or one of its subdomains.
+func HostInDomain(host, domain string) bool {
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+	if host == "" || domain == "" {
+		return false
+	}
+	return host == domain || strings.HasSuffix(host, "."+domain)
+}
+
+// DisplayURL shortens rawURL for display by dropping the scheme and a bare
+// trailing slash, and cutting it off after maxDisplayURLLength code points.
+func DisplayURL(rawURL string) string {
+	display := rawURL
+	if n := urlScheme([]rune(rawURL)); n > 0 {
+		display = string([]rune(rawURL)[n:])
+	}
+	if strings.Count(display, "/") == 1 {
+		display = strings.TrimSuffix(display, "/")
+	}
+
+	runes := []rune(display)
+	if len(runes) > maxDisplayURLLength {
+		return string(runes[:maxDisplayURLLength]) + "…"
+	}
+	return display
+}
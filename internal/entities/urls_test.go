@@ -0,0 +1,142 @@
+package entities
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseURLs(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected []URL
+	}{
+		{
+			name:     "no urls",
+			body:     "just a regular chirp",
+			expected: []URL{},
+		},
+		{
+			name: "single url",
+			body: "read https://example.com/post?id=1 now",
+			expected: []URL{
+				{URL: "https://example.com/post?id=1", Start: 5, End: 34},
+			},
+		},
+		{
+			name: "trailing punctuation is trimmed",
+			body: "see http://example.com.",
+			expected: []URL{
+				{URL: "http://example.com", Start: 4, End: 22},
+			},
+		},
+		{
+			name: "unbalanced closing paren is trimmed",
+			body: "(https://example.com/a)",
+			expected: []URL{
+				{URL: "https://example.com/a", Start: 1, End: 22},
+			},
+		},
+		{
+			name: "balanced parens are kept",
+			body: "https://en.wikipedia.org/wiki/Go_(language)",
+			expected: []URL{
+				{URL: "https://en.wikipedia.org/wiki/Go_(language)", Start: 0, End: 43},
+			},
+		},
+		{
+			name:     "scheme without host",
+			body:     "https:// is not a link",
+			expected: []URL{},
+		},
+		{
+			name:     "scheme inside a word",
+			body:     "xhttps://example.com",
+			expected: []URL{},
+		},
+		{
+			name: "offsets count code points",
+			body: "🎉 HTTPS://example.com",
+			expected: []URL{
+				{URL: "HTTPS://example.com", Start: 2, End: 21},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseURLs(tt.body)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ParseURLs(%q) = %+v, want %+v", tt.body, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestURLHost(t *testing.T) {
+	tests := []struct {
+		url      string
+		expected string
+	}{
+		{url: "https://example.com/a", expected: "example.com"},
+		{url: "HTTP://WWW.Example.COM:8080/", expected: "www.example.com"},
+		{url: "https://example.com./", expected: "example.com"},
+		{url: "https://user@example.com", expected: "example.com"},
+		{url: "https://%zz", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			actual := URLHost(tt.url)
+			if actual != tt.expected {
+				t.Errorf("URLHost(%q): expected %q, got %q", tt.url, tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestHostInDomain(t *testing.T) {
+	tests := []struct {
+		name     string
+		host     string
+		domain   string
+		expected bool
+	}{
+		{name: "exact match", host: "example.com", domain: "example.com", expected: true},
+		{name: "subdomain", host: "www.example.com", domain: "example.com", expected: true},
+		{name: "domain is case insensitive", host: "example.com", domain: "Example.COM", expected: true},
+		{name: "suffix without dot", host: "badexample.com", domain: "example.com", expected: false},
+		{name: "parent domain", host: "example.com", domain: "www.example.com", expected: false},
+		{name: "empty domain", host: "example.com", domain: "", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := HostInDomain(tt.host, tt.domain)
+			if actual != tt.expected {
+				t.Errorf("HostInDomain(%q, %q): expected %v", tt.host, tt.domain, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDisplayURL(t *testing.T) {
+	tests := []struct {
+		url      string
+		expected string
+	}{
+		{url: "https://example.com/", expected: "example.com"},
+		{url: "http://example.com/a/", expected: "example.com/a/"},
+		{url: "HTTPS://example.com/post?id=1", expected: "example.com/post?id=1"},
+		{url: "https://example.com/a/very/long/path/to/something", expected: "example.com/a/very/long/path/t…"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			actual := DisplayURL(tt.url)
+			if actual != tt.expected {
+				t.Errorf("DisplayURL(%q): expected %q, got %q", tt.url, tt.expected, actual)
+			}
+		})
+	}
+}
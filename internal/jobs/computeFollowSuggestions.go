@@ -0,0 +1,76 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+	"Chirpy/internal/suggestions"
+)
+
+// ComputeFollowSuggestions recomputes the follow suggestions of every user
+// whose suggestions are older than refreshInterval, batchSize users at a
+// time. New users are handled first since they have none yet.
+func ComputeFollowSuggestions(queries *database.Queries, refreshInterval time.Duration, batchSize int32) Job {
+	return func(ctx context.Context) error {
+		for {
+			now := time.Now().UTC()
+			userIDs, err := queries.ReadUsersDueForSuggestions(ctx, database.ReadUsersDueForSuggestionsParams{
+				RefreshedBefore: now.Add(-refreshInterval),
+				RowLimit:        batchSize,
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, userID := range userIDs {
+				rows, err := queries.ReadSuggestionCandidates(ctx, database.ReadSuggestionCandidatesParams{
+					UserID:      userID,
+					ActiveSince: now.Add(-suggestions.ActivityWindow),
+				})
+				if err != nil {
+					return err
+				}
+
+				candidates := make([]suggestions.Candidate, len(rows))
+				for i, row := range rows {
+					candidates[i] = suggestions.Candidate{
+						UserID:             row.UserID,
+						MutualCount:        row.MutualCount,
+						SharedHashtagCount: row.SharedHashtagCount,
+						RecentChirpCount:   row.RecentChirpCount,
+					}
+				}
+
+				params := database.ReplaceFollowSuggestionsParams{
+					UserID:              userID,
+					SuggestedIds:        []uuid.UUID{},
+					Scores:              []float64{},
+					MutualCounts:        []int64{},
+					SharedHashtagCounts: []int64{},
+					RecentChirpCounts:   []int64{},
+				}
+				for _, suggestion := range suggestions.Rank(candidates, suggestions.MaxSuggestions) {
+					params.SuggestedIds = append(params.SuggestedIds, suggestion.UserID)
+					params.Scores = append(params.Scores, suggestion.Score)
+					params.MutualCounts = append(params.MutualCounts, suggestion.MutualCount)
+					params.SharedHashtagCounts = append(params.SharedHashtagCounts, suggestion.SharedHashtagCount)
+					params.RecentChirpCounts = append(params.RecentChirpCounts, suggestion.RecentChirpCount)
+				}
+				err = queries.ReplaceFollowSuggestions(ctx, params)
+				if err != nil {
+					return err
+				}
+			}
+			if len(userIDs) > 0 {
+				log.Printf("Computed follow suggestions for %d users", len(userIDs))
+			}
+			if len(userIDs) < int(batchSize) {
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"Chirpy/internal/database"
+	"Chirpy/internal/trends"
+)
+
+// ComputeTrends ranks the hashtags used in public chirps over the last
+// trends.Window against their usual rate and stores the result as a new
+// snapshot. Snapshots older than retention are removed, except the latest,
+// so trends are still served if the job falls behind.
+func ComputeTrends(queries *database.Queries, retention time.Duration) Job {
+	return func(ctx context.Context) error {
+		now := time.Now().UTC()
+		windowStart := now.Add(-trends.Window)
+
+		rows, err := queries.ReadHashtagActivity(ctx, database.ReadHashtagActivityParams{
+			BaselineStart: windowStart.Add(-trends.Baseline),
+			WindowStart:   windowStart,
+			TopAuthors:    trends.TopAuthors,
+		})
+		if err != nil {
+			return err
+		}
+
+		activity := make([]trends.Activity, len(rows))
+		for i, row := range rows {
+			activity[i] = trends.Activity{
+				Tag:                 row.Tag,
+				ChirpCount:          row.ChirpCount,
+				AuthorCount:         row.AuthorCount,
+				TopAuthorChirpCount: row.TopAuthorChirpCount,
+				BaselineCount:       row.BaselineCount,
+			}
+		}
+
+		params := database.CreateTrendSnapshotParams{
+			WindowStart:    windowStart,
+			Tags:           []string{},
+			Scores:         []float64{},
+			ChirpCounts:    []int64{},
+			AuthorCounts:   []int64{},
+			BaselineCounts: []int64{},
+		}
+		for _, trend := range trends.Rank(activity, trends.MaxTrends) {
+			params.Tags = append(params.Tags, trend.Tag)
+			params.Scores = append(params.Scores, trend.Score)
+			params.ChirpCounts = append(params.ChirpCounts, trend.ChirpCount)
+			params.AuthorCounts = append(params.AuthorCounts, trend.AuthorCount)
+			params.BaselineCounts = append(params.BaselineCounts, trend.BaselineCount)
+		}
+		err = queries.CreateTrendSnapshot(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		deleted, err := queries.DeleteTrendSnapshots(ctx, now.Add(-retention))
+		if err != nil {
+			return err
+		}
+		if deleted > 0 {
+			log.Printf("Deleted %d old trend snapshots", deleted)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,44 @@
+package jobs
+
+import (
+	"context"
+	"log"
+
+	"Chirpy/internal/blobstore"
+	"Chirpy/internal/database"
+)
+
+// DeleteBlobs removes queued blobs from the blob store. A key stays queued
+// until its blob is gone, so a store outage only delays the cleanup.
+func DeleteBlobs(queries *database.Queries, store blobstore.BlobStore, batchSize int32) Job {
+	return func(ctx context.Context) error {
+		for {
+			keys, err := queries.ReadBlobDeletions(ctx, batchSize)
+			if err != nil {
+				return err
+			}
+
+			deleted := []string{}
+			for _, key := range keys {
+				err := store.Delete(ctx, key)
+				if err != nil {
+					log.Printf("Error deleting blob %s: %s", key, err)
+					continue
+				}
+				deleted = append(deleted, key)
+			}
+
+			err = queries.DeleteBlobDeletions(ctx, deleted)
+			if err != nil {
+				return err
+			}
+			if len(deleted) > 0 {
+				log.Printf("Deleted %d blobs", len(deleted))
+			}
+			// Failed keys are left for the next run rather than retried now.
+			if len(keys) < int(batchSize) || len(deleted) < len(keys) {
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"Chirpy/internal/database"
+)
+
+// DeleteOrphanedMedia removes uploads that were never attached to a chirp
+// or used as an avatar within ttl, and queues their blobs for deletion.
+func DeleteOrphanedMedia(queries *database.Queries, ttl time.Duration, batchSize int32) Job {
+	return func(ctx context.Context) error {
+		for {
+			deleted, err := queries.DeleteOrphanedMedia(ctx, database.DeleteOrphanedMediaParams{
+				CreatedBefore: time.Now().UTC().Add(-ttl),
+				RowLimit:      batchSize,
+			})
+			if err != nil {
+				return err
+			}
+			if deleted > 0 {
+				log.Printf("Deleted %d orphaned uploads", deleted)
+			}
+			if deleted < int64(batchSize) {
+				return nil
+			}
+		}
+	}
+}
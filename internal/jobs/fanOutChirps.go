@@ -0,0 +1,31 @@
+package jobs
+
+import (
+	"context"
+	"log"
+
+	"Chirpy/internal/database"
+)
+
+// FanOutChirps copies newly published chirps into the home timelines of
+// their authors' followers. Chirps that weren't marked fanned_out when they
+// were queued, because their author had too many followers, are skipped;
+// they are merged into timelines when they are read. Each batch is claimed
+// and written in a single statement, so a chirp is never dropped from the
+// queue without being fanned out.
+func FanOutChirps(queries *database.Queries, batchSize int32) Job {
+	return func(ctx context.Context) error {
+		for {
+			result, err := queries.FanOutChirps(ctx, batchSize)
+			if err != nil {
+				return err
+			}
+			if result.Chirps > 0 {
+				log.Printf("Fanned out %d chirps to %d timeline entries", result.Chirps, result.Entries)
+			}
+			if result.Chirps < int64(batchSize) {
+				return nil
+			}
+		}
+	}
+}
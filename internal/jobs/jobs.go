@@ -0,0 +1,31 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+type Job func(ctx context.Context) error
+
+// Run calls job immediately and then once per interval until ctx is
+// cancelled. A run that is already in progress is allowed to finish, so
+// shutting down never abandons a batch halfway through.
+func Run(ctx context.Context, name string, interval time.Duration, job Job) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		err := job(context.WithoutCancel(ctx))
+		if err != nil {
+			log.Printf("Error running job %s: %s", name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Printf("Stopped job %s", name)
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRun_StopsWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var runs atomic.Int32
+
+	done := make(chan struct{})
+	go func() {
+		Run(ctx, "test", time.Millisecond, func(ctx context.Context) error {
+			if runs.Add(1) == 3 {
+				cancel()
+			}
+			return errors.New("errors are logged, not fatal")
+		})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not stop after cancel")
+	}
+
+	if runs.Load() != 3 {
+		t.Errorf("Expected 3 runs, got %d", runs.Load())
+	}
+}
+
+func TestRun_InProgressJobIsNotCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var jobErr error
+	Run(ctx, "test", time.Hour, func(jobCtx context.Context) error {
+		cancel()
+		jobErr = jobCtx.Err()
+		return nil
+	})
+
+	if jobErr != nil {
+		t.Errorf("Expected job context to outlive cancel, got %v", jobErr)
+	}
+}
@@ -0,0 +1,29 @@
+package jobs
+
+import (
+	"context"
+	"log"
+
+	"Chirpy/internal/database"
+)
+
+// NotifyMentions turns mentions in published chirps into notifications.
+// Mentions in scheduled chirps wait until the chirp is published, and
+// mentions the recipient can't see or has muted are marked without
+// notifying anyone.
+func NotifyMentions(queries *database.Queries, batchSize int32) Job {
+	return func(ctx context.Context) error {
+		for {
+			mentions, err := queries.NotifyMentions(ctx, batchSize)
+			if err != nil {
+				return err
+			}
+			if mentions > 0 {
+				log.Printf("Processed %d mentions for notifications", mentions)
+			}
+			if mentions < int64(batchSize) {
+				return nil
+			}
+		}
+	}
+}
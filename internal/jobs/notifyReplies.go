@@ -0,0 +1,28 @@
+package jobs
+
+import (
+	"context"
+	"log"
+
+	"Chirpy/internal/database"
+)
+
+// NotifyReplies lets authors know about replies to their chirps once the
+// replies are published. Replies the author can't see, has muted or wrote
+// themselves are marked without notifying anyone.
+func NotifyReplies(queries *database.Queries, batchSize int32) Job {
+	return func(ctx context.Context) error {
+		for {
+			replies, err := queries.NotifyReplies(ctx, batchSize)
+			if err != nil {
+				return err
+			}
+			if replies > 0 {
+				log.Printf("Processed %d replies for notifications", replies)
+			}
+			if replies < int64(batchSize) {
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package jobs
+
+import (
+	"context"
+	"log"
+
+	"Chirpy/internal/database"
+)
+
+// PublishScheduledChirps publishes chirps whose publish_at has passed. The
+// rows are claimed with FOR UPDATE SKIP LOCKED inside a single UPDATE, so
+// when several servers run this job each chirp is published exactly once.
+// Published chirps are queued for fan-out, and marked for the materialized
+// timelines when their author has at most fanOutLimit followers.
+func PublishScheduledChirps(queries *database.Queries, fanOutLimit int64, batchSize int32) Job {
+	return func(ctx context.Context) error {
+		for {
+			chirps, err := queries.PublishDueChirps(ctx, database.PublishDueChirpsParams{
+				FanOutLimit: fanOutLimit,
+				RowLimit:    batchSize,
+			})
+			if err != nil {
+				return err
+			}
+			if len(chirps) > 0 {
+				log.Printf("Published %d scheduled chirps", len(chirps))
+			}
+			if len(chirps) < int(batchSize) {
+				return nil
+			}
+		}
+	}
+}
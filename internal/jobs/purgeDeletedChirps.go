@@ -0,0 +1,34 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"Chirpy/internal/database"
+)
+
+// PurgeDeletedChirps permanently removes chirps that were soft deleted more
+// than retention ago. Until then a tombstone is kept so admins can restore
+// the chirp and moderators can review it. The blobs of purged media are
+// queued for DeleteBlobs.
+func PurgeDeletedChirps(queries *database.Queries, retention time.Duration, batchSize int32) Job {
+	return func(ctx context.Context) error {
+		for {
+			purged, err := queries.PurgeDeletedChirps(ctx, database.PurgeDeletedChirpsParams{
+				DeletedBefore: sql.NullTime{Time: time.Now().UTC().Add(-retention), Valid: true},
+				RowLimit:      batchSize,
+			})
+			if err != nil {
+				return err
+			}
+			if purged > 0 {
+				log.Printf("Purged %d deleted chirps", purged)
+			}
+			if purged < int64(batchSize) {
+				return nil
+			}
+		}
+	}
+}
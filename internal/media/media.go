@@ -0,0 +1,108 @@
+package media
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"golang.org/x/image/draw"
+)
+
+const (
+	MaxUploadBytes = 5 << 20
+	MaxDimension   = 4096
+	ThumbnailSize  = 320
+)
+
+var ErrUnsupportedType = errors.New("only JPEG and PNG images are supported")
+
+// Image is an upload that has been decoded and re-encoded. Re-encoding drops
+// every metadata segment (EXIF, XMP, ICC comments), so nothing the camera
+// recorded such as GPS coordinates survives.
+type Image struct {
+	ContentType string
+	Data        []byte
+	Thumbnail   []byte
+	Width       int
+	Height      int
+}
+
+// Process validates an uploaded image by sniffing its content rather than
+// trusting the client, strips its metadata and renders a thumbnail whose
+// longest side is at most ThumbnailSize pixels.
+func Process(data []byte) (Image, error) {
+	contentType := http.DetectContentType(data)
+	if contentType != "image/jpeg" && contentType != "image/png" {
+		return Image{}, ErrUnsupportedType
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return Image{}, fmt.Errorf("invalid image: %w", err)
+	}
+	if config.Width > MaxDimension || config.Height > MaxDimension {
+		return Image{}, fmt.Errorf("image must be at most %dx%d pixels", MaxDimension, MaxDimension)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return Image{}, fmt.Errorf("invalid image: %w", err)
+	}
+
+	cleaned, err := encode(img, contentType)
+	if err != nil {
+		return Image{}, err
+	}
+
+	thumbnail, err := encode(resize(img, ThumbnailSize), contentType)
+	if err != nil {
+		return Image{}, err
+	}
+
+	bounds := img.Bounds()
+	return Image{
+		ContentType: contentType,
+		Data:        cleaned,
+		Thumbnail:   thumbnail,
+		Width:       bounds.Dx(),
+		Height:      bounds.Dy(),
+	}, nil
+}
+
+func resize(img image.Image, maxSide int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= maxSide && height <= maxSide {
+		return img
+	}
+
+	if width >= height {
+		height = max(1, height*maxSide/width)
+		width = maxSide
+	} else {
+		width = max(1, width*maxSide/height)
+		height = maxSide
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Over, nil)
+	return dst
+}
+
+func encode(img image.Image, contentType string) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	if contentType == "image/png" {
+		err = png.Encode(&buf, img)
+	} else {
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 85})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,82 @@
+package media
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func testImage(width, height int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	return img
+}
+
+func TestProcess_PNGThumbnail(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, testImage(800, 400)); err != nil {
+		t.Fatalf("Failed to encode test image: %v", err)
+	}
+
+	processed, err := Process(buf.Bytes())
+	if err != nil {
+		t.Fatalf("Process failed: %v", err)
+	}
+
+	if processed.ContentType != "image/png" {
+		t.Errorf("Expected image/png, got %s", processed.ContentType)
+	}
+	if processed.Width != 800 || processed.Height != 400 {
+		t.Errorf("Expected 800x400, got %dx%d", processed.Width, processed.Height)
+	}
+
+	thumbnail, err := png.DecodeConfig(bytes.NewReader(processed.Thumbnail))
+	if err != nil {
+		t.Fatalf("Thumbnail is not a valid PNG: %v", err)
+	}
+	if thumbnail.Width != ThumbnailSize || thumbnail.Height != ThumbnailSize/2 {
+		t.Errorf("Expected %dx%d thumbnail, got %dx%d", ThumbnailSize, ThumbnailSize/2, thumbnail.Width, thumbnail.Height)
+	}
+}
+
+func TestProcess_StripsEXIF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, testImage(64, 64), nil); err != nil {
+		t.Fatalf("Failed to encode test image: %v", err)
+	}
+
+	// Splice an APP1 EXIF segment in right after the SOI marker.
+	payload := []byte("Exif\x00\x00GPS secret location")
+	segment := []byte{0xFF, 0xE1, byte((len(payload) + 2) >> 8), byte(len(payload) + 2)}
+	segment = append(segment, payload...)
+	original := buf.Bytes()
+	withEXIF := append([]byte{}, original[:2]...)
+	withEXIF = append(withEXIF, segment...)
+	withEXIF = append(withEXIF, original[2:]...)
+
+	processed, err := Process(withEXIF)
+	if err != nil {
+		t.Fatalf("Process failed: %v", err)
+	}
+
+	if bytes.Contains(processed.Data, []byte("Exif")) || bytes.Contains(processed.Data, []byte("GPS secret")) {
+		t.Error("Expected EXIF metadata to be stripped")
+	}
+	if processed.ContentType != "image/jpeg" {
+		t.Errorf("Expected image/jpeg, got %s", processed.ContentType)
+	}
+}
+
+func TestProcess_RejectsNonImages(t *testing.T) {
+	_, err := Process([]byte("<html><body>not an image</body></html>"))
+	if err != ErrUnsupportedType {
+		t.Errorf("Expected ErrUnsupportedType, got %v", err)
+	}
+}
@@ -0,0 +1,94 @@
+package notifications
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	TypeFollow         = "follow"
+	TypeMention        = "mention"
+	TypeFollowRequest  = "follow_request"
+	TypeFollowApproved = "follow_approved"
+	TypeReply          = "reply"
+	TypeLike           = "like"
+)
+
+// Types lists every notification type that is produced.
+var Types = []string{TypeFollow, TypeMention, TypeFollowRequest, TypeFollowApproved, TypeReply, TypeLike}
+
+// SummaryActors is how many actors a summary names before the rest are
+// counted as "others".
+const SummaryActors = 2
+
+var actions = map[string]string{
+	TypeFollow:         "followed you",
+	TypeMention:        "mentioned you",
+	TypeFollowRequest:  "requested to follow you",
+	TypeFollowApproved: "approved your follow request",
+	TypeReply:          "replied to your chirp",
+	TypeLike:           "liked your chirp",
+}
+
+// ParseTypes reads a type filter given as repeated or comma separated
+// values. An empty result means every type.
+func ParseTypes(values []string) ([]string, error) {
+	types := []string{}
+	for _, value := range values {
+		for _, notificationType := range strings.Split(value, ",") {
+			notificationType = strings.TrimSpace(notificationType)
+			if _, ok := actions[notificationType]; !ok {
+				return nil, fmt.Errorf("unknown notification type: %s", notificationType)
+			}
+			types = append(types, notificationType)
+		}
+	}
+	return types, nil
+}
+
+// Summary describes a grouped notification, e.g. "@alice and 4 others
+// followed you". handles are the most recent actors first; an actor without
+// a handle is called "someone". actorCount is the size of the whole group.
+func Summary(notificationType string, handles []string, actorCount int64) string {
+	action, ok := actions[notificationType]
+	if !ok {
+		action = "interacted with you"
+	}
+
+	names := []string{}
+	for _, handle := range handles {
+		if len(names) == SummaryActors {
+			break
+		}
+		if handle == "" {
+			names = append(names, "someone")
+			continue
+		}
+		names = append(names, "@"+handle)
+	}
+	if len(names) == 0 {
+		return "Someone " + action
+	}
+	if names[0] == "someone" {
+		names[0] = "Someone"
+	}
+
+	others := actorCount - int64(len(names))
+	switch {
+	case others == 1:
+		names = append(names, "1 other")
+	case others > 1:
+		names = append(names, fmt.Sprintf("%d others", others))
+	}
+
+	var subject string
+	switch len(names) {
+	case 1:
+		subject = names[0]
+	case 2:
+		subject = names[0] + " and " + names[1]
+	default:
+		subject = strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+	}
+	return subject + " " + action
+}
@@ -0,0 +1,155 @@
+package notifications
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSummary(t *testing.T) {
+	tests := []struct {
+		name             string
+		notificationType string
+		handles          []string
+		actorCount       int64
+		expected         string
+	}{
+		{
+			name:             "single actor",
+			notificationType: TypeFollow,
+			handles:          []string{"alice"},
+			actorCount:       1,
+			expected:         "@alice followed you",
+		},
+		{
+			name:             "two actors",
+			notificationType: TypeFollow,
+			handles:          []string{"alice", "bob"},
+			actorCount:       2,
+			expected:         "@alice and @bob followed you",
+		},
+		{
+			name:             "one other",
+			notificationType: TypeMention,
+			handles:          []string{"alice", "bob"},
+			actorCount:       3,
+			expected:         "@alice, @bob and 1 other mentioned you",
+		},
+		{
+			name:             "many others",
+			notificationType: TypeFollow,
+			handles:          []string{"alice"},
+			actorCount:       5,
+			expected:         "@alice and 4 others followed you",
+		},
+		{
+			name:             "extra handles are counted",
+			notificationType: TypeFollow,
+			handles:          []string{"alice", "bob", "carol"},
+			actorCount:       3,
+			expected:         "@alice, @bob and 1 other followed you",
+		},
+		{
+			name:             "actor without a handle",
+			notificationType: TypeMention,
+			handles:          []string{""},
+			actorCount:       1,
+			expected:         "Someone mentioned you",
+		},
+		{
+			name:             "follow requests",
+			notificationType: TypeFollowRequest,
+			handles:          []string{"alice", "bob"},
+			actorCount:       2,
+			expected:         "@alice and @bob requested to follow you",
+		},
+		{
+			name:             "follow request approved",
+			notificationType: TypeFollowApproved,
+			handles:          []string{"alice"},
+			actorCount:       1,
+			expected:         "@alice approved your follow request",
+		},
+		{
+			name:             "replies",
+			notificationType: TypeReply,
+			handles:          []string{"alice", "bob"},
+			actorCount:       2,
+			expected:         "@alice and @bob replied to your chirp",
+		},
+		{
+			name:             "likes",
+			notificationType: TypeLike,
+			handles:          []string{"alice"},
+			actorCount:       5,
+			expected:         "@alice and 4 others liked your chirp",
+		},
+		{
+			name:             "no actors left",
+			notificationType: TypeFollow,
+			handles:          []string{},
+			actorCount:       0,
+			expected:         "Someone followed you",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Summary(tt.notificationType, tt.handles, tt.actorCount)
+			if got != tt.expected {
+				t.Errorf("Summary(%q, %v, %d): expected %q, got %q", tt.notificationType, tt.handles, tt.actorCount, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseTypes(t *testing.T) {
+	tests := []struct {
+		name      string
+		values    []string
+		expected  []string
+		expectErr bool
+	}{
+		{
+			name:     "no filter",
+			values:   nil,
+			expected: []string{},
+		},
+		{
+			name:     "repeated",
+			values:   []string{"follow", "mention"},
+			expected: []string{"follow", "mention"},
+		},
+		{
+			name:     "comma separated",
+			values:   []string{"follow, mention"},
+			expected: []string{"follow", "mention"},
+		},
+		{
+			name:     "follow requests",
+			values:   []string{"follow_request,follow_approved"},
+			expected: []string{"follow_request", "follow_approved"},
+		},
+		{
+			name:     "replies and likes",
+			values:   []string{"reply", "like"},
+			expected: []string{"reply", "like"},
+		},
+		{
+			name:      "unknown type",
+			values:    []string{"repost"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTypes(tt.values)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("Expected error %v, got %v", tt.expectErr, err)
+			}
+			if !tt.expectErr && !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+// EncodeCursor turns a position in a result set into an opaque string that
+// clients pass back unchanged to fetch the next page.
+func EncodeCursor(position interface{}) (string, error) {
+	dat, err := json.Marshal(position)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(dat), nil
+}
+
+func DecodeCursor(cursor string, position interface{}) error {
+	dat, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return fmt.Errorf("invalid cursor")
+	}
+	err = json.Unmarshal(dat, position)
+	if err != nil {
+		return fmt.Errorf("invalid cursor")
+	}
+	return nil
+}
+
+// ParseLimit reads a page size, falling back to DefaultLimit when none is
+// given and capping it at MaxLimit.
+func ParseLimit(limit string) (int, error) {
+	if limit == "" {
+		return DefaultLimit, nil
+	}
+
+	n, err := strconv.Atoi(limit)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("limit must be a positive integer")
+	}
+	if n > MaxLimit {
+		return MaxLimit, nil
+	}
+	return n, nil
+}
+
+// NextLink builds an RFC 8288 Link header value pointing at the next page of
+// the current request.
+func NextLink(current *url.URL, cursor string) string {
+	query := current.Query()
+	query.Set("cursor", cursor)
+	next := url.URL{Path: current.Path, RawQuery: query.Encode()}
+	return fmt.Sprintf("<%s>; rel=\"next\"", next.String())
+}
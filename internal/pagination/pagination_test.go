@@ -0,0 +1,89 @@
+package pagination
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	type position struct {
+		CreatedAt time.Time `json:"t"`
+		ID        uuid.UUID `json:"id"`
+	}
+
+	original := position{
+		CreatedAt: time.Date(2025, 3, 14, 15, 9, 26, 535897000, time.UTC),
+		ID:        uuid.New(),
+	}
+
+	cursor, err := EncodeCursor(original)
+	if err != nil {
+		t.Fatalf("EncodeCursor failed: %v", err)
+	}
+
+	var decoded position
+	err = DecodeCursor(cursor, &decoded)
+	if err != nil {
+		t.Fatalf("DecodeCursor failed: %v", err)
+	}
+
+	if !decoded.CreatedAt.Equal(original.CreatedAt) || decoded.ID != original.ID {
+		t.Errorf("Expected %v, got %v", original, decoded)
+	}
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	var position struct{}
+	for _, cursor := range []string{"not base64!", "bm90IGpzb24"} {
+		if err := DecodeCursor(cursor, &position); err == nil {
+			t.Errorf("Expected error for cursor %q", cursor)
+		}
+	}
+}
+
+func TestParseLimit(t *testing.T) {
+	tests := []struct {
+		name          string
+		limit         string
+		expected      int
+		expectedError bool
+	}{
+		{"default", "", DefaultLimit, false},
+		{"explicit", "5", 5, false},
+		{"capped", "1000", MaxLimit, false},
+		{"zero", "0", 0, true},
+		{"negative", "-3", 0, true},
+		{"not a number", "ten", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limit, err := ParseLimit(tt.limit)
+			if tt.expectedError && err == nil {
+				t.Errorf("Expected error but got none")
+			}
+			if !tt.expectedError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+			if limit != tt.expected {
+				t.Errorf("Expected limit %d, got %d", tt.expected, limit)
+			}
+		})
+	}
+}
+
+func TestNextLink(t *testing.T) {
+	current, err := url.Parse("/api/chirps?sort=desc&cursor=old&limit=10")
+	if err != nil {
+		t.Fatalf("Failed to parse URL: %v", err)
+	}
+
+	link := NextLink(current, "new")
+	expected := `</api/chirps?cursor=new&limit=10&sort=desc>; rel="next"`
+	if link != expected {
+		t.Errorf("Expected %s, got %s", expected, link)
+	}
+}
@@ -0,0 +1,110 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"Chirpy/internal/entities"
+)
+
+const dateLayout = "2006-01-02"
+
+// Query is a parsed search string. Text keeps the free-text part in Postgres
+// websearch syntax ("exact phrases", -excluded words, OR), while the
+// operators are pulled out into structured filters.
+type Query struct {
+	Text     string
+	From     string
+	Since    time.Time
+	Until    time.Time
+	Hashtags []string
+}
+
+// Parse splits a raw search string into free text and the from:handle,
+// since:YYYY-MM-DD, until:YYYY-MM-DD and #hashtag operators. Until is
+// inclusive of the given day, so the returned bound is the start of the next.
+func Parse(raw string) (Query, error) {
+	query := Query{Hashtags: []string{}}
+	text := []string{}
+
+	for _, token := range tokenize(raw) {
+		name, value, isOperator := strings.Cut(token, ":")
+		if isOperator && !strings.HasPrefix(token, "\"") {
+			switch strings.ToLower(name) {
+			case "from":
+				handle := entities.NormalizeHandle(value)
+				if !entities.ValidHandle(handle) {
+					return Query{}, fmt.Errorf("invalid handle in from: operator")
+				}
+				query.From = handle
+				continue
+			case "since":
+				since, err := time.Parse(dateLayout, value)
+				if err != nil {
+					return Query{}, fmt.Errorf("since: must be a date formatted as YYYY-MM-DD")
+				}
+				query.Since = since
+				continue
+			case "until":
+				until, err := time.Parse(dateLayout, value)
+				if err != nil {
+					return Query{}, fmt.Errorf("until: must be a date formatted as YYYY-MM-DD")
+				}
+				query.Until = until.AddDate(0, 0, 1)
+				continue
+			}
+		}
+
+		if strings.HasPrefix(token, "#") {
+			tag := entities.NormalizeHashtag(token)
+			if !entities.ValidHashtag(tag) {
+				return Query{}, fmt.Errorf("invalid hashtag %s", token)
+			}
+			query.Hashtags = append(query.Hashtags, tag)
+			continue
+		}
+
+		text = append(text, token)
+	}
+
+	query.Text = strings.Join(text, " ")
+
+	if !query.Since.IsZero() && !query.Until.IsZero() && !query.Since.Before(query.Until) {
+		return Query{}, fmt.Errorf("since: must be before until:")
+	}
+	if query.Text == "" && query.From == "" && len(query.Hashtags) == 0 &&
+		query.Since.IsZero() && query.Until.IsZero() {
+		return Query{}, fmt.Errorf("search query is empty")
+	}
+
+	return query, nil
+}
+
+// tokenize splits on whitespace while keeping quoted phrases, including a
+// leading '-', together as one token.
+func tokenize(raw string) []string {
+	tokens := []string{}
+	var current strings.Builder
+	inQuotes := false
+
+	for _, r := range raw {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			current.WriteRune(r)
+		case !inQuotes && (r == ' ' || r == '\t' || r == '\n'):
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+
+	return tokens
+}
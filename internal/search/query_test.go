@@ -0,0 +1,97 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name          string
+		raw           string
+		expected      Query
+		expectedError bool
+	}{
+		{
+			name: "plain text",
+			raw:  "hello world",
+			expected: Query{
+				Text:     "hello world",
+				Hashtags: []string{},
+			},
+		},
+		{
+			name: "phrase and exclusion are kept for websearch",
+			raw:  `"boot dev" -python`,
+			expected: Query{
+				Text:     `"boot dev" -python`,
+				Hashtags: []string{},
+			},
+		},
+		{
+			name: "operator inside a phrase is text",
+			raw:  `"from:alice says"`,
+			expected: Query{
+				Text:     `"from:alice says"`,
+				Hashtags: []string{},
+			},
+		},
+		{
+			name: "all operators",
+			raw:  "go from:@Alice since:2025-01-01 until:2025-01-31 #GoLang",
+			expected: Query{
+				Text:     "go",
+				From:     "alice",
+				Since:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				Until:    time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+				Hashtags: []string{"golang"},
+			},
+		},
+		{
+			name: "filters only",
+			raw:  "#chirpy",
+			expected: Query{
+				Hashtags: []string{"chirpy"},
+			},
+		},
+		{
+			name:          "empty query",
+			raw:           "   ",
+			expectedError: true,
+		},
+		{
+			name:          "bad date",
+			raw:           "since:yesterday",
+			expectedError: true,
+		},
+		{
+			name:          "since after until",
+			raw:           "since:2025-02-01 until:2025-01-01",
+			expectedError: true,
+		},
+		{
+			name:          "bad handle",
+			raw:           "from:not-a-handle",
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := Parse(tt.raw)
+			if tt.expectedError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error but got: %v", err)
+			}
+			if !reflect.DeepEqual(query, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, query)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package suggestions
+
+import (
+	"bytes"
+	"cmp"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// ActivityWindow is how far back hashtags and chirps count as recent.
+	ActivityWindow = 30 * 24 * time.Hour
+	// MaxSuggestions is how many suggestions are stored for each user.
+	MaxSuggestions = 50
+)
+
+// Each signal is weighted on a log scale, so the hundredth mutual follow
+// adds far less than the second. Activity only counts up to about a chirp a
+// day, so posting a lot is no substitute for being followed by friends.
+const (
+	mutualWeight        = 3.0
+	sharedHashtagWeight = 2.0
+	activityWeight      = 1.0
+	maxRecentChirps     = 30
+)
+
+// Candidate is an account the user might follow. MutualCount is how many of
+// the accounts the user follows follow it, SharedHashtagCount how many of
+// the user's recent hashtags it has used recently too, and RecentChirpCount
+// how many public chirps it posted within ActivityWindow.
+type Candidate struct {
+	UserID             uuid.UUID
+	MutualCount        int64
+	SharedHashtagCount int64
+	RecentChirpCount   int64
+}
+
+type Suggestion struct {
+	Candidate
+	Score float64
+}
+
+func Score(candidate Candidate) float64 {
+	return mutualWeight*math.Log1p(float64(candidate.MutualCount)) +
+		sharedHashtagWeight*math.Log1p(float64(candidate.SharedHashtagCount)) +
+		activityWeight*math.Log1p(float64(min(candidate.RecentChirpCount, maxRecentChirps)))
+}
+
+// Rank scores candidates and returns up to limit of them, best first.
+// Candidates with nothing in common with the user are dropped, however
+// active they are.
+func Rank(candidates []Candidate, limit int) []Suggestion {
+	suggestions := []Suggestion{}
+	for _, candidate := range candidates {
+		if candidate.MutualCount == 0 && candidate.SharedHashtagCount == 0 {
+			continue
+		}
+		suggestions = append(suggestions, Suggestion{Candidate: candidate, Score: Score(candidate)})
+	}
+
+	slices.SortFunc(suggestions, func(a, b Suggestion) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return bytes.Compare(a.UserID[:], b.UserID[:])
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions
+}
@@ -0,0 +1,95 @@
+package suggestions
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestRank(t *testing.T) {
+	alice := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	bob := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	carol := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+
+	tests := []struct {
+		name       string
+		candidates []Candidate
+		limit      int
+		expected   []uuid.UUID
+	}{
+		{
+			name:       "no candidates",
+			candidates: nil,
+			limit:      10,
+			expected:   []uuid.UUID{},
+		},
+		{
+			name: "mutual follows outweigh shared hashtags",
+			candidates: []Candidate{
+				{UserID: alice, SharedHashtagCount: 2},
+				{UserID: bob, MutualCount: 2},
+			},
+			limit:    10,
+			expected: []uuid.UUID{bob, alice},
+		},
+		{
+			name: "activity breaks ties",
+			candidates: []Candidate{
+				{UserID: alice, MutualCount: 1},
+				{UserID: bob, MutualCount: 1, RecentChirpCount: 20},
+			},
+			limit:    10,
+			expected: []uuid.UUID{bob, alice},
+		},
+		{
+			name: "activity alone is not enough",
+			candidates: []Candidate{
+				{UserID: alice, RecentChirpCount: 500},
+				{UserID: bob, SharedHashtagCount: 1},
+			},
+			limit:    10,
+			expected: []uuid.UUID{bob},
+		},
+		{
+			name: "many mutuals are not swamped by activity",
+			candidates: []Candidate{
+				{UserID: alice, MutualCount: 1, RecentChirpCount: 1000},
+				{UserID: bob, MutualCount: 10},
+			},
+			limit:    10,
+			expected: []uuid.UUID{bob, alice},
+		},
+		{
+			name: "equal scores ordered by ID",
+			candidates: []Candidate{
+				{UserID: carol, MutualCount: 1},
+				{UserID: alice, MutualCount: 1},
+			},
+			limit:    10,
+			expected: []uuid.UUID{alice, carol},
+		},
+		{
+			name: "limited",
+			candidates: []Candidate{
+				{UserID: alice, MutualCount: 3},
+				{UserID: bob, MutualCount: 2},
+				{UserID: carol, MutualCount: 1},
+			},
+			limit:    2,
+			expected: []uuid.UUID{alice, bob},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ids := []uuid.UUID{}
+			for _, suggestion := range Rank(tc.candidates, tc.limit) {
+				ids = append(ids, suggestion.UserID)
+			}
+			if !reflect.DeepEqual(ids, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, ids)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package timeline
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+)
+
+// Depth is how many recent chirps of each followed account are copied into
+// a home timeline when a follow is backfilled or a timeline is rebuilt.
+const Depth = 200
+
+// Rebuild replaces userID's materialized home timeline with the most recent
+// chirps of every account they follow. It repairs timelines that missed
+// fan-outs, for example after restoring from a backup.
+func Rebuild(ctx context.Context, db *sql.DB, queries *database.Queries, userID uuid.UUID) (int64, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	qtx := queries.WithTx(tx)
+
+	err = qtx.DeleteHomeTimeline(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	entries, err := qtx.RebuildHomeTimeline(ctx, database.RebuildHomeTimelineParams{
+		PerAuthorLimit: Depth,
+		UserID:         userID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return entries, tx.Commit()
+}
+
+// Merge combines lists of chirps that are each sorted newest first into one
+// list in the same order, keeping the first copy of any chirp that appears in
+// more than one list and stopping after limit chirps.
+func Merge(limit int, lists ...[]database.Chirp) []database.Chirp {
+	merged := make([]database.Chirp, 0, limit)
+	seen := make(map[uuid.UUID]bool)
+	next := make([]int, len(lists))
+	for len(merged) < limit {
+		best := -1
+		for i, list := range lists {
+			if next[i] == len(list) {
+				continue
+			}
+			if best == -1 || newer(list[next[i]], lists[best][next[best]]) {
+				best = i
+			}
+		}
+		if best == -1 {
+			break
+		}
+
+		chirp := lists[best][next[best]]
+		next[best]++
+		if seen[chirp.ID] {
+			continue
+		}
+		seen[chirp.ID] = true
+		merged = append(merged, chirp)
+	}
+	return merged
+}
+
+// newer reports whether a sorts before b in a newest-first timeline. Chirps
+// created at the same instant are ordered by ID, matching the timeline
+// queries' ORDER BY created_at DESC, id DESC.
+func newer(a, b database.Chirp) bool {
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		return a.CreatedAt.After(b.CreatedAt)
+	}
+	return bytes.Compare(a.ID[:], b.ID[:]) > 0
+}
@@ -0,0 +1,98 @@
+package timeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"Chirpy/internal/database"
+)
+
+func TestMerge(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	chirp := func(id byte, minutes int) database.Chirp {
+		return database.Chirp{
+			ID:        uuid.UUID{id},
+			CreatedAt: base.Add(time.Duration(minutes) * time.Minute),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		limit    int
+		lists    [][]database.Chirp
+		expected []byte
+	}{
+		{
+			name:     "no lists",
+			limit:    10,
+			expected: []byte{},
+		},
+		{
+			name:     "single list is unchanged",
+			limit:    10,
+			lists:    [][]database.Chirp{{chirp(3, 3), chirp(2, 2), chirp(1, 1)}},
+			expected: []byte{3, 2, 1},
+		},
+		{
+			name:  "lists are interleaved newest first",
+			limit: 10,
+			lists: [][]database.Chirp{
+				{chirp(5, 5), chirp(3, 3), chirp(1, 1)},
+				{chirp(4, 4), chirp(2, 2)},
+			},
+			expected: []byte{5, 4, 3, 2, 1},
+		},
+		{
+			name:  "chirps in both lists appear once",
+			limit: 10,
+			lists: [][]database.Chirp{
+				{chirp(3, 3), chirp(2, 2)},
+				{chirp(3, 3), chirp(1, 1)},
+			},
+			expected: []byte{3, 2, 1},
+		},
+		{
+			name:  "same time is ordered by id descending",
+			limit: 10,
+			lists: [][]database.Chirp{
+				{chirp(1, 0)},
+				{chirp(2, 0)},
+			},
+			expected: []byte{2, 1},
+		},
+		{
+			name:  "result stops at limit",
+			limit: 3,
+			lists: [][]database.Chirp{
+				{chirp(6, 6), chirp(4, 4), chirp(2, 2)},
+				{chirp(5, 5), chirp(3, 3), chirp(1, 1)},
+			},
+			expected: []byte{6, 5, 4},
+		},
+		{
+			name:  "duplicates do not count toward limit",
+			limit: 2,
+			lists: [][]database.Chirp{
+				{chirp(3, 3), chirp(2, 2)},
+				{chirp(3, 3), chirp(1, 1)},
+			},
+			expected: []byte{3, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Merge(tt.limit, tt.lists...)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("Merge() returned %d chirps, want %d", len(got), len(tt.expected))
+			}
+			for i, id := range tt.expected {
+				if got[i].ID != (uuid.UUID{id}) {
+					t.Errorf("Merge()[%d] = %s, want %s", i, got[i].ID, uuid.UUID{id})
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package trends
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"time"
+)
+
+const (
+	// Window is the recent period a hashtag's usage is measured over.
+	Window = time.Hour
+	// Baseline is the period before Window used to work out how often a
+	// hashtag is normally used.
+	Baseline = 24 * time.Hour
+	// MinChirps is how many chirps a hashtag needs within Window to trend.
+	MinChirps = 5
+	// MinScore is how far above its usual rate a hashtag has to be.
+	MinScore = 1.0
+	// TopAuthors is how many of a hashtag's most active authors are checked
+	// when deciding whether a handful of accounts dominate it.
+	TopAuthors = 3
+	// MaxTopAuthorShare is the largest share of a hashtag's chirps within
+	// Window that its TopAuthors may have written.
+	MaxTopAuthorShare = 0.6
+	// MaxTrends is how many trends a snapshot keeps.
+	MaxTrends = 20
+)
+
+// Activity is how a hashtag was used within Window and during the Baseline
+// before it.
+type Activity struct {
+	Tag                 string
+	ChirpCount          int64
+	AuthorCount         int64
+	TopAuthorChirpCount int64
+	BaselineCount       int64
+}
+
+type Trend struct {
+	Activity
+	Score float64
+}
+
+// Score measures how far usage within Window is above the rate the baseline
+// predicts, scaled by the square root of that rate. A quiet hashtag that
+// suddenly picks up outranks a busy one growing by the same amount.
+func Score(chirpCount, baselineCount int64) float64 {
+	expected := float64(baselineCount) * float64(Window) / float64(Baseline)
+	return (float64(chirpCount) - expected) / math.Sqrt(expected+1)
+}
+
+// Dominated reports whether a handful of accounts wrote most of a hashtag's
+// chirps, which is what a spam campaign or a single thread looks like.
+func Dominated(activity Activity) bool {
+	return float64(activity.TopAuthorChirpCount) > MaxTopAuthorShare*float64(activity.ChirpCount)
+}
+
+// Rank scores every hashtag and returns up to limit that are trending,
+// highest score first.
+func Rank(activity []Activity, limit int) []Trend {
+	trends := []Trend{}
+	for _, a := range activity {
+		if a.ChirpCount < MinChirps || Dominated(a) {
+			continue
+		}
+		score := Score(a.ChirpCount, a.BaselineCount)
+		if score < MinScore {
+			continue
+		}
+		trends = append(trends, Trend{Activity: a, Score: score})
+	}
+
+	slices.SortFunc(trends, func(a, b Trend) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Tag, b.Tag)
+	})
+	if len(trends) > limit {
+		trends = trends[:limit]
+	}
+	return trends
+}
@@ -0,0 +1,111 @@
+package trends
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		name          string
+		chirpCount    int64
+		baselineCount int64
+		expected      float64
+	}{
+		{"new hashtag", 10, 0, 10},
+		{"usual rate", 2, 48, 0},
+		{"above usual rate", 8, 72, 2.5},
+		{"below usual rate", 0, 24, -1 / 1.4142135623730951},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			score := Score(tc.chirpCount, tc.baselineCount)
+			if score != tc.expected {
+				t.Errorf("Expected %v, got %v", tc.expected, score)
+			}
+		})
+	}
+}
+
+func TestRank(t *testing.T) {
+	tests := []struct {
+		name     string
+		activity []Activity
+		limit    int
+		expected []string
+	}{
+		{
+			name:     "no activity",
+			activity: nil,
+			limit:    10,
+			expected: []string{},
+		},
+		{
+			name: "highest score first",
+			activity: []Activity{
+				{Tag: "steady", ChirpCount: 20, AuthorCount: 20, TopAuthorChirpCount: 3, BaselineCount: 240},
+				{Tag: "rising", ChirpCount: 10, AuthorCount: 10, TopAuthorChirpCount: 3, BaselineCount: 0},
+				{Tag: "growing", ChirpCount: 30, AuthorCount: 30, TopAuthorChirpCount: 3, BaselineCount: 240},
+			},
+			limit:    10,
+			expected: []string{"rising", "growing", "steady"},
+		},
+		{
+			name: "ties broken by tag",
+			activity: []Activity{
+				{Tag: "beta", ChirpCount: 6, AuthorCount: 6, TopAuthorChirpCount: 3},
+				{Tag: "alpha", ChirpCount: 6, AuthorCount: 6, TopAuthorChirpCount: 3},
+			},
+			limit:    10,
+			expected: []string{"alpha", "beta"},
+		},
+		{
+			name: "too few chirps",
+			activity: []Activity{
+				{Tag: "quiet", ChirpCount: MinChirps - 1, AuthorCount: MinChirps - 1, TopAuthorChirpCount: 3},
+			},
+			limit:    10,
+			expected: []string{},
+		},
+		{
+			name: "not above usual rate",
+			activity: []Activity{
+				{Tag: "daily", ChirpCount: 10, AuthorCount: 10, TopAuthorChirpCount: 3, BaselineCount: 240},
+			},
+			limit:    10,
+			expected: []string{},
+		},
+		{
+			name: "dominated by a few accounts",
+			activity: []Activity{
+				{Tag: "spam", ChirpCount: 50, AuthorCount: 4, TopAuthorChirpCount: 48},
+				{Tag: "organic", ChirpCount: 5, AuthorCount: 5, TopAuthorChirpCount: 3},
+			},
+			limit:    10,
+			expected: []string{"organic"},
+		},
+		{
+			name: "limited",
+			activity: []Activity{
+				{Tag: "first", ChirpCount: 30, AuthorCount: 30, TopAuthorChirpCount: 3},
+				{Tag: "second", ChirpCount: 20, AuthorCount: 20, TopAuthorChirpCount: 3},
+				{Tag: "third", ChirpCount: 10, AuthorCount: 10, TopAuthorChirpCount: 3},
+			},
+			limit:    2,
+			expected: []string{"first", "second"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tags := []string{}
+			for _, trend := range Rank(tc.activity, tc.limit) {
+				tags = append(tags, trend.Tag)
+			}
+			if !reflect.DeepEqual(tags, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, tags)
+			}
+		})
+	}
+}
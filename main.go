@@ -1,19 +1,43 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
 
 	"Chirpy/handlers"
+	"Chirpy/internal/blobstore"
 	"Chirpy/internal/database"
+	"Chirpy/internal/jobs"
 
 	"github.com/joho/godotenv"
 	_ "github.com/lib/pq"
 )
 
+// chirpRetention is how long a deleted chirp is kept as a restorable
+// tombstone before it is purged.
+const chirpRetention = 30 * 24 * time.Hour
+
+// orphanedMediaTTL is how long an upload may stay unattached to a chirp or
+// profile before it is deleted.
+const orphanedMediaTTL = 24 * time.Hour
+
+// suggestionRefreshInterval is how often each user's follow suggestions are
+// recomputed.
+const suggestionRefreshInterval = 24 * time.Hour
+
+// trendRetention is how long trend snapshots are kept as history.
+const trendRetention = 7 * 24 * time.Hour
+
 func main() {
     apiCfg := &handlers.ApiConfig{}
     godotenv.Load()
@@ -22,6 +46,21 @@ func main() {
     jwtSecret := os.Getenv("JWT_SECRET")
     polkaKey := os.Getenv("POLKA_KEY")
 	platform := os.Getenv("PLATFORM")
+	mediaRoot := os.Getenv("MEDIA_ROOT")
+	adminKey := os.Getenv("ADMIN_KEY")
+	deniedLinkDomains := listEnv("LINK_DENYLIST")
+	maxChirpLength, err := intEnv("CHIRP_MAX_LENGTH", 140)
+	if err != nil {
+		log.Fatal(err)
+	}
+	maxChirpLengthRed, err := intEnv("CHIRPY_RED_MAX_LENGTH", 280)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fanOutFollowerLimit, err := intEnv("FANOUT_FOLLOWER_LIMIT", 10000)
+	if err != nil {
+		log.Fatal(err)
+	}
     
     if dbURL == "" {
         log.Fatal("DB_URL must be set")
@@ -35,6 +74,9 @@ func main() {
 	if platform == "" {
         log.Fatal("PLATFORM must be set")
     }
+	if mediaRoot == "" {
+		mediaRoot = "media"
+	}
     
     db, err := sql.Open("postgres", dbURL)
     if err != nil {
@@ -43,35 +85,98 @@ func main() {
     }
     
     dbQueries := database.New(db)
+    apiCfg.Db = db
     apiCfg.DbQueries = dbQueries
     apiCfg.JwtSecret = jwtSecret
     apiCfg.PolkaKey = polkaKey
 	apiCfg.Platform = platform
+	apiCfg.AdminKey = adminKey
+	apiCfg.ChirpRetention = chirpRetention
+	apiCfg.MaxChirpLength = maxChirpLength
+	apiCfg.MaxChirpLengthRed = maxChirpLengthRed
+	apiCfg.DeniedLinkDomains = deniedLinkDomains
+	apiCfg.FanOutFollowerLimit = int64(fanOutFollowerLimit)
+
+	blobStore, err := blobstore.NewLocalStore(mediaRoot, "/media")
+	if err != nil {
+		log.Fatal(err)
+	}
+	apiCfg.BlobStore = blobStore
 
 	filepathRoot := "."
 	port := "8080"
 
-	mux := http.NewServeMux()
-	mux.Handle("/app/", http.StripPrefix("/app", apiCfg.MiddlewareMetricsInc(http.FileServer(http.Dir(filepathRoot)))))
-	mux.HandleFunc("GET /api/healthz", handlers.HandlerReadiness)
-	mux.HandleFunc("GET /admin/metrics", apiCfg.HandlerMetrics)
-	mux.HandleFunc("POST /admin/reset", apiCfg.HandlerReset)
-	mux.HandleFunc("POST /api/users", apiCfg.HandlerCreateUser)
-	mux.HandleFunc("POST /api/chirps", apiCfg.HandlerCreateChirps)
-	mux.HandleFunc("GET /api/chirps", apiCfg.HandlerReadChirps)
-	mux.HandleFunc("GET /api/chirps/{chirpID}", apiCfg.HandlerReadChirpsByID)
-	mux.HandleFunc("POST /api/login", apiCfg.HandlerLogin)
-	mux.HandleFunc("POST /api/refresh", apiCfg.HandlerRefresh)
-	mux.HandleFunc("POST /api/revoke", apiCfg.HandlerRevoke)
-	mux.HandleFunc("PUT /api/users", apiCfg.HandlerUpdateUsers)
-	mux.HandleFunc("DELETE /api/chirps/{chirpID}", apiCfg.HandlerDeleteChirps)
-	mux.HandleFunc("POST /api/polka/webhooks", apiCfg.HandlerPolkaWebhook)
+	mux := newMux(apiCfg, filepathRoot)
 
 	srv := &http.Server{
 		Addr:    ":" + port,
 		Handler: mux,
 	}
 
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var workers sync.WaitGroup
+	startJob := func(name string, interval time.Duration, job jobs.Job) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			jobs.Run(ctx, name, interval, job)
+		}()
+	}
+	startJob("publish scheduled chirps", 10*time.Second, jobs.PublishScheduledChirps(dbQueries, int64(fanOutFollowerLimit), 100))
+	startJob("fan out chirps", 2*time.Second, jobs.FanOutChirps(dbQueries, 100))
+	startJob("notify mentions", 5*time.Second, jobs.NotifyMentions(dbQueries, 100))
+	startJob("notify replies", 5*time.Second, jobs.NotifyReplies(dbQueries, 100))
+	startJob("compute trends", 5*time.Minute, jobs.ComputeTrends(dbQueries, trendRetention))
+	startJob("compute follow suggestions", 10*time.Minute, jobs.ComputeFollowSuggestions(dbQueries, suggestionRefreshInterval, 100))
+	startJob("purge deleted chirps", time.Hour, jobs.PurgeDeletedChirps(dbQueries, chirpRetention, 100))
+	startJob("delete orphaned media", time.Hour, jobs.DeleteOrphanedMedia(dbQueries, orphanedMediaTTL, 100))
+	startJob("delete blobs", time.Minute, jobs.DeleteBlobs(dbQueries, blobStore, 100))
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		err := srv.Shutdown(shutdownCtx)
+		if err != nil {
+			log.Printf("Error shutting down server: %s", err)
+		}
+	}()
+
 	log.Printf("Serving files from %s on port: %s\n", filepathRoot, port)
-	log.Fatal(srv.ListenAndServe())
+	err = srv.ListenAndServe()
+	if err != nil && err != http.ErrServerClosed {
+		log.Fatal(err)
+	}
+
+	workers.Wait()
+	log.Printf("Server stopped")
 }
+
+// intEnv reads a positive integer from the environment, falling back to def
+// when the variable is unset.
+func intEnv(name string, def int) (int, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("%s must be a positive integer", name)
+	}
+	return n, nil
+}
+
+// listEnv reads a comma separated list from the environment, skipping empty
+// entries.
+func listEnv(name string) []string {
+	values := []string{}
+	for _, value := range strings.Split(os.Getenv(name), ",") {
+		value = strings.TrimSpace(value)
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
\ No newline at end of file
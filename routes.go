@@ -0,0 +1,100 @@
+package main
+
+import (
+	"net/http"
+
+	"Chirpy/handlers"
+)
+
+// newMux registers every route the server exposes. ServeMux panics when two
+// patterns conflict, so keeping this separate from main lets the route table
+// be checked without a database.
+func newMux(apiCfg *handlers.ApiConfig, filepathRoot string) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.Handle("/app/", http.StripPrefix("/app", apiCfg.MiddlewareMetricsInc(http.FileServer(http.Dir(filepathRoot)))))
+	mux.HandleFunc("GET /api/healthz", handlers.HandlerReadiness)
+	mux.HandleFunc("GET /admin/metrics", apiCfg.HandlerMetrics)
+	mux.HandleFunc("POST /admin/reset", apiCfg.HandlerReset)
+	mux.HandleFunc("DELETE /admin/chirps/{chirpID}", apiCfg.HandlerAdminDeleteChirps)
+	mux.HandleFunc("POST /admin/chirps/{chirpID}/restore", apiCfg.HandlerAdminRestoreChirps)
+	mux.HandleFunc("PUT /admin/chirps/{chirpID}/sensitivity", apiCfg.HandlerAdminUpdateChirpSensitivity)
+	mux.HandleFunc("GET /admin/hashtags/flagged", apiCfg.HandlerAdminReadFlaggedHashtags)
+	mux.HandleFunc("PUT /admin/hashtags/{tag}/flag", apiCfg.HandlerAdminFlagHashtags)
+	mux.HandleFunc("DELETE /admin/hashtags/{tag}/flag", apiCfg.HandlerAdminUnflagHashtags)
+	mux.HandleFunc("POST /api/users", apiCfg.HandlerCreateUser)
+	mux.HandleFunc("POST /api/chirps", apiCfg.HandlerCreateChirps)
+	mux.HandleFunc("GET /api/chirps", apiCfg.HandlerReadChirps)
+	mux.HandleFunc("GET /api/chirps/search", apiCfg.HandlerSearchChirps)
+	mux.HandleFunc("GET /api/trends", apiCfg.HandlerReadTrends)
+	mux.HandleFunc("GET /api/chirps/scheduled", apiCfg.HandlerReadScheduledChirps)
+	mux.HandleFunc("PUT /api/chirps/{chirpID}/schedule", apiCfg.HandlerRescheduleChirp)
+	mux.HandleFunc("DELETE /api/chirps/{chirpID}/schedule", apiCfg.HandlerCancelScheduledChirp)
+	mux.HandleFunc("GET /api/chirps/{chirpID}", apiCfg.HandlerReadChirpsByID)
+	mux.HandleFunc("POST /api/chirps/{chirpID}/poll/votes", apiCfg.HandlerVotePoll)
+	mux.HandleFunc("POST /api/chirps/{chirpID}/pin", apiCfg.HandlerPinChirps)
+	mux.HandleFunc("DELETE /api/chirps/{chirpID}/pin", apiCfg.HandlerUnpinChirps)
+	mux.HandleFunc("POST /api/chirps/{chirpID}/bookmark", apiCfg.HandlerCreateBookmarks)
+	mux.HandleFunc("DELETE /api/chirps/{chirpID}/bookmark", apiCfg.HandlerDeleteBookmarks)
+	mux.HandleFunc("POST /api/chirps/{chirpID}/like", apiCfg.HandlerCreateLikes)
+	mux.HandleFunc("DELETE /api/chirps/{chirpID}/like", apiCfg.HandlerDeleteLikes)
+	mux.HandleFunc("GET /api/bookmarks", apiCfg.HandlerReadBookmarks)
+	mux.HandleFunc("POST /api/login", apiCfg.HandlerLogin)
+	mux.HandleFunc("POST /api/refresh", apiCfg.HandlerRefresh)
+	mux.HandleFunc("POST /api/revoke", apiCfg.HandlerRevoke)
+	mux.HandleFunc("PUT /api/users", apiCfg.HandlerUpdateUsers)
+	mux.HandleFunc("PUT /api/users/me/profile", apiCfg.HandlerUpdateProfiles)
+	mux.HandleFunc("PATCH /api/users/me/settings", apiCfg.HandlerUpdateUserSettings)
+	mux.HandleFunc("GET /api/users/me/mentions", apiCfg.HandlerReadMentions)
+	mux.HandleFunc("GET /api/users/suggestions", apiCfg.HandlerReadFollowSuggestions)
+	mux.HandleFunc("GET /api/users/{idOrHandle}", apiCfg.HandlerReadProfiles)
+	mux.HandleFunc("POST /api/users/{userID}/follow", apiCfg.HandlerFollowUsers)
+	mux.HandleFunc("DELETE /api/users/{userID}/follow", apiCfg.HandlerUnfollowUsers)
+	mux.HandleFunc("GET /api/users/{userID}/followers", apiCfg.HandlerReadFollowers)
+	mux.HandleFunc("GET /api/users/{userID}/following", apiCfg.HandlerReadFollowing)
+	mux.HandleFunc("POST /api/users/{userID}/block", apiCfg.HandlerBlockUsers)
+	mux.HandleFunc("DELETE /api/users/{userID}/block", apiCfg.HandlerUnblockUsers)
+	mux.HandleFunc("POST /api/users/{userID}/mute", apiCfg.HandlerMuteUsers)
+	mux.HandleFunc("DELETE /api/users/{userID}/mute", apiCfg.HandlerUnmuteUsers)
+	mux.HandleFunc("GET /api/users/me/blocks", apiCfg.HandlerReadBlocks)
+	mux.HandleFunc("GET /api/users/me/mutes", apiCfg.HandlerReadMutes)
+	mux.HandleFunc("POST /api/users/me/muted-keywords", apiCfg.HandlerCreateMutedKeywords)
+	mux.HandleFunc("GET /api/users/me/muted-keywords", apiCfg.HandlerReadMutedKeywords)
+	mux.HandleFunc("DELETE /api/users/me/muted-keywords/{keywordID}", apiCfg.HandlerDeleteMutedKeywords)
+	mux.HandleFunc("GET /api/follow-requests", apiCfg.HandlerReadFollowRequests)
+	mux.HandleFunc("POST /api/follow-requests/{userID}/approve", apiCfg.HandlerApproveFollowRequests)
+	mux.HandleFunc("POST /api/follow-requests/{userID}/deny", apiCfg.HandlerDenyFollowRequests)
+	mux.HandleFunc("GET /api/timeline/home", apiCfg.HandlerReadHomeTimeline)
+	mux.HandleFunc("GET /api/notifications", apiCfg.HandlerReadNotifications)
+	mux.HandleFunc("GET /api/notifications/unread-count", apiCfg.HandlerReadUnreadNotificationCount)
+	mux.HandleFunc("POST /api/notifications/read", apiCfg.HandlerMarkAllNotificationsRead)
+	mux.HandleFunc("POST /api/notifications/{notificationID}/read", apiCfg.HandlerMarkNotificationsRead)
+	mux.HandleFunc("POST /api/lists", apiCfg.HandlerCreateLists)
+	mux.HandleFunc("GET /api/lists", apiCfg.HandlerReadLists)
+	mux.HandleFunc("GET /api/lists/{listID}", apiCfg.HandlerReadListsByID)
+	mux.HandleFunc("PUT /api/lists/{listID}", apiCfg.HandlerUpdateLists)
+	mux.HandleFunc("DELETE /api/lists/{listID}", apiCfg.HandlerDeleteLists)
+	mux.HandleFunc("GET /api/lists/{listID}/members", apiCfg.HandlerReadListMembers)
+	mux.HandleFunc("POST /api/lists/{listID}/members", apiCfg.HandlerCreateListMembers)
+	mux.HandleFunc("DELETE /api/lists/{listID}/members/{userID}", apiCfg.HandlerDeleteListMembers)
+	mux.HandleFunc("GET /api/lists/{listID}/timeline", apiCfg.HandlerReadListTimeline)
+	mux.HandleFunc("POST /api/conversations", apiCfg.HandlerCreateConversations)
+	mux.HandleFunc("GET /api/conversations", apiCfg.HandlerReadConversations)
+	mux.HandleFunc("GET /api/conversations/{conversationID}", apiCfg.HandlerReadConversationsByID)
+	mux.HandleFunc("PUT /api/conversations/{conversationID}", apiCfg.HandlerUpdateConversations)
+	mux.HandleFunc("POST /api/conversations/{conversationID}/messages", apiCfg.HandlerCreateMessages)
+	mux.HandleFunc("GET /api/conversations/{conversationID}/messages", apiCfg.HandlerReadMessages)
+	mux.HandleFunc("POST /api/conversations/{conversationID}/read", apiCfg.HandlerMarkConversationsRead)
+	mux.HandleFunc("DELETE /api/chirps/{chirpID}", apiCfg.HandlerDeleteChirps)
+	mux.HandleFunc("POST /api/polka/webhooks", apiCfg.HandlerPolkaWebhook)
+	mux.HandleFunc("POST /api/media", apiCfg.HandlerUploadMedia)
+	mux.HandleFunc("GET /media/{key...}", apiCfg.HandlerReadMediaBlob)
+	mux.HandleFunc("GET /l/{code}", apiCfg.HandlerFollowLink)
+	mux.HandleFunc("POST /api/drafts", apiCfg.HandlerCreateDrafts)
+	mux.HandleFunc("GET /api/drafts", apiCfg.HandlerReadDrafts)
+	mux.HandleFunc("GET /api/drafts/{draftID}", apiCfg.HandlerReadDraftsByID)
+	mux.HandleFunc("PUT /api/drafts/{draftID}", apiCfg.HandlerUpdateDrafts)
+	mux.HandleFunc("DELETE /api/drafts/{draftID}", apiCfg.HandlerDeleteDrafts)
+	mux.HandleFunc("POST /api/drafts/{draftID}/publish", apiCfg.HandlerPublishDraft)
+
+	return mux
+}
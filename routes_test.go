@@ -0,0 +1,43 @@
+package main
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"Chirpy/handlers"
+)
+
+func TestNewMux(t *testing.T) {
+	mux := newMux(&handlers.ApiConfig{}, ".")
+
+	tests := []struct {
+		method   string
+		path     string
+		expected string
+	}{
+		{"GET", "/api/chirps/scheduled", "GET /api/chirps/scheduled"},
+		{"GET", "/api/chirps/search", "GET /api/chirps/search"},
+		{"GET", "/api/chirps/abc", "GET /api/chirps/{chirpID}"},
+		{"PUT", "/api/chirps/abc/schedule", "PUT /api/chirps/{chirpID}/schedule"},
+		{"DELETE", "/api/chirps/abc/schedule", "DELETE /api/chirps/{chirpID}/schedule"},
+		{"DELETE", "/api/chirps/scheduled/pin", "DELETE /api/chirps/{chirpID}/pin"},
+		{"POST", "/api/chirps/abc/like", "POST /api/chirps/{chirpID}/like"},
+		{"DELETE", "/api/chirps/abc", "DELETE /api/chirps/{chirpID}"},
+		{"GET", "/api/users/suggestions", "GET /api/users/suggestions"},
+		{"GET", "/api/users/alice", "GET /api/users/{idOrHandle}"},
+		{"PUT", "/api/users/me/profile", "PUT /api/users/me/profile"},
+		{"PATCH", "/api/users/me/settings", "PATCH /api/users/me/settings"},
+		{"GET", "/api/users/me/blocks", "GET /api/users/me/blocks"},
+		{"POST", "/api/users/abc/follow", "POST /api/users/{userID}/follow"},
+		{"GET", "/media/ab/cd.jpg", "GET /media/{key...}"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.method+" "+tc.path, func(t *testing.T) {
+			_, pattern := mux.Handler(httptest.NewRequest(tc.method, tc.path, nil))
+			if pattern != tc.expected {
+				t.Errorf("Expected pattern %q, got %q", tc.expected, pattern)
+			}
+		})
+	}
+}
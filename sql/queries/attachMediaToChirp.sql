@@ -0,0 +1,11 @@
+-- name: AttachMediaToChirp :execrows
+UPDATE media_attachments
+SET chirp_id = $1, position = $2
+WHERE id = $3
+    AND user_id = $4
+    AND chirp_id IS NULL
+    AND NOT EXISTS (
+        SELECT 1
+        FROM users
+        WHERE users.avatar_media_id = media_attachments.id
+    );
\ No newline at end of file
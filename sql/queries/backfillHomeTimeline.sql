@@ -0,0 +1,10 @@
+-- name: BackfillHomeTimeline :exec
+INSERT INTO home_timeline_entries (user_id, chirp_id, author_id, created_at)
+SELECT @user_id::uuid, chirps.id, @author_id::uuid, chirps.created_at
+FROM chirps
+WHERE chirps.user_id = @author_id::uuid
+    AND chirps.published
+    AND chirps.deleted_at IS NULL
+ORDER BY chirps.created_at DESC, chirps.id DESC
+LIMIT @row_limit
+ON CONFLICT (user_id, chirp_id) DO NOTHING;
\ No newline at end of file
@@ -0,0 +1,18 @@
+-- name: CancelScheduledChirp :execrows
+WITH cancelled AS (
+    SELECT id
+    FROM chirps
+    WHERE id = $1
+        AND user_id = $2
+        AND NOT published
+    FOR UPDATE
+), queued AS (
+    INSERT INTO blob_deletions (blob_key, created_at)
+    SELECT keys.blob_key, NOW()
+    FROM media_attachments
+    CROSS JOIN LATERAL (VALUES (media_attachments.blob_key), (media_attachments.thumbnail_key)) AS keys(blob_key)
+    WHERE media_attachments.chirp_id IN (SELECT id FROM cancelled)
+    ON CONFLICT (blob_key) DO NOTHING
+)
+DELETE FROM chirps
+WHERE id IN (SELECT id FROM cancelled);
\ No newline at end of file
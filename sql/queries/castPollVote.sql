@@ -0,0 +1,16 @@
+-- name: CastPollVote :one
+INSERT INTO poll_votes (poll_id, user_id, option_id, created_at, updated_at)
+SELECT polls.id, @user_id, poll_options.id, NOW(), NOW()
+FROM polls
+INNER JOIN poll_options ON poll_options.poll_id = polls.id
+WHERE polls.chirp_id = @chirp_id
+    AND poll_options.id = @option_id
+    AND polls.closes_at > NOW()
+ON CONFLICT (poll_id, user_id) DO UPDATE
+SET option_id = EXCLUDED.option_id, updated_at = NOW()
+WHERE (
+    SELECT closes_at
+    FROM polls
+    WHERE polls.id = poll_votes.poll_id
+) > NOW()
+RETURNING *;
\ No newline at end of file
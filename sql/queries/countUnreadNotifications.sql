@@ -0,0 +1,7 @@
+-- name: CountUnreadNotifications :one
+SELECT COUNT(*)
+FROM notifications
+LEFT JOIN chirps ON chirps.id = notifications.chirp_id
+WHERE notifications.user_id = @user_id
+    AND notifications.read_at IS NULL
+    AND (notifications.chirp_id IS NULL OR chirp_visible_to(chirps, @user_id, true));
\ No newline at end of file
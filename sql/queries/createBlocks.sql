@@ -0,0 +1,8 @@
+-- name: CreateBlock :exec
+INSERT INTO blocks (blocker_id, blocked_id, created_at)
+VALUES (
+    $1,
+    $2,
+    NOW()
+)
+ON CONFLICT (blocker_id, blocked_id) DO NOTHING;
\ No newline at end of file
@@ -0,0 +1,8 @@
+-- name: CreateChirpHashtag :exec
+INSERT INTO chirp_hashtags (chirp_id, tag, created_at)
+VALUES (
+    $1,
+    $2,
+    NOW()
+)
+ON CONFLICT (chirp_id, tag) DO NOTHING;
\ No newline at end of file
@@ -0,0 +1,7 @@
+-- name: CreateChirpLink :exec
+INSERT INTO chirp_links (chirp_id, link_id)
+VALUES (
+    $1,
+    $2
+)
+ON CONFLICT (chirp_id, link_id) DO NOTHING;
\ No newline at end of file
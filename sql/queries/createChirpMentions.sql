@@ -0,0 +1,10 @@
+-- name: CreateChirpMention :exec
+INSERT INTO chirp_mentions (chirp_id, user_id, handle, created_at, notified_at)
+VALUES (
+    $1,
+    $2,
+    $3,
+    NOW(),
+    NULL
+)
+ON CONFLICT (chirp_id, user_id) DO NOTHING;
\ No newline at end of file
@@ -1,10 +1,26 @@
 -- name: CreateChirp :one
-INSERT INTO chirps (id, created_at, updated_at, body, user_id)
-VALUES (
-    gen_random_uuid(),
-    Now(),
-    Now(),
-    $1,
-    $2
+WITH created AS (
+    INSERT INTO chirps (id, created_at, updated_at, body, user_id, publish_at, published, visibility, content_warning, sensitive, reply_to_id)
+    VALUES (
+        gen_random_uuid(),
+        Now(),
+        Now(),
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8
+    )
+    RETURNING *
+), replied AS (
+    UPDATE chirps
+    SET reply_count = reply_count + 1
+    FROM created
+    WHERE chirps.id = created.reply_to_id
+        AND created.published
 )
-RETURNING *;
\ No newline at end of file
+SELECT *
+FROM created;
\ No newline at end of file
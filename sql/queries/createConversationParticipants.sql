@@ -0,0 +1,9 @@
+-- name: CreateConversationParticipant :execrows
+INSERT INTO conversation_participants (conversation_id, user_id, joined_at, last_read_at)
+VALUES (
+    $1,
+    $2,
+    NOW(),
+    NULL
+)
+ON CONFLICT (conversation_id, user_id) DO NOTHING;
\ No newline at end of file
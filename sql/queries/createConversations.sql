@@ -0,0 +1,14 @@
+-- name: CreateConversation :one
+INSERT INTO conversations (id, created_at, updated_at, created_by, filter_profanity, direct_user_a, direct_user_b)
+VALUES (
+    gen_random_uuid(),
+    NOW(),
+    NOW(),
+    @created_by,
+    @filter_profanity,
+    sqlc.narg('direct_user_a'),
+    sqlc.narg('direct_user_b')
+)
+ON CONFLICT (LEAST(direct_user_a, direct_user_b), GREATEST(direct_user_a, direct_user_b)) WHERE direct_user_a IS NOT NULL AND direct_user_b IS NOT NULL
+DO UPDATE SET direct_user_a = conversations.direct_user_a
+RETURNING *;
\ No newline at end of file
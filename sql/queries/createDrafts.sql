@@ -0,0 +1,13 @@
+-- name: CreateDraft :one
+INSERT INTO drafts (id, created_at, updated_at, body, user_id, visibility, content_warning, sensitive)
+VALUES (
+    gen_random_uuid(),
+    NOW(),
+    NOW(),
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+)
+RETURNING *;
\ No newline at end of file
@@ -0,0 +1,8 @@
+-- name: CreateFollowRequest :execrows
+INSERT INTO follow_requests (requester_id, target_id, created_at)
+VALUES (
+    $1,
+    $2,
+    NOW()
+)
+ON CONFLICT (requester_id, target_id) DO NOTHING;
\ No newline at end of file
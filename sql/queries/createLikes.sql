@@ -0,0 +1,15 @@
+-- name: CreateLike :execrows
+WITH liked AS (
+    INSERT INTO likes (user_id, chirp_id, created_at)
+    VALUES (
+        $1,
+        $2,
+        NOW()
+    )
+    ON CONFLICT (user_id, chirp_id) DO NOTHING
+    RETURNING chirp_id
+)
+UPDATE chirps
+SET like_count = like_count + 1
+FROM liked
+WHERE chirps.id = liked.chirp_id;
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- name: CreateLink :one
+INSERT INTO links (id, created_at, code, url)
+VALUES (
+    gen_random_uuid(),
+    NOW(),
+    $1,
+    $2
+)
+ON CONFLICT (url) DO UPDATE SET url = EXCLUDED.url
+RETURNING *;
\ No newline at end of file
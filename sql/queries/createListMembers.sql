@@ -0,0 +1,8 @@
+-- name: CreateListMember :exec
+INSERT INTO list_members (list_id, user_id, created_at)
+VALUES (
+    $1,
+    $2,
+    NOW()
+)
+ON CONFLICT (list_id, user_id) DO NOTHING;
\ No newline at end of file
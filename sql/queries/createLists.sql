@@ -0,0 +1,12 @@
+-- name: CreateList :one
+INSERT INTO lists (id, created_at, updated_at, user_id, name, description, visibility)
+VALUES (
+    gen_random_uuid(),
+    NOW(),
+    NOW(),
+    $1,
+    $2,
+    $3,
+    $4
+)
+RETURNING *;
\ No newline at end of file
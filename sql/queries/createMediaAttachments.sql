@@ -0,0 +1,16 @@
+-- name: CreateMediaAttachment :one
+INSERT INTO media_attachments (id, created_at, user_id, chirp_id, position, content_type, width, height, size_bytes, blob_key, thumbnail_key)
+VALUES (
+    $1,
+    NOW(),
+    $2,
+    NULL,
+    NULL,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8
+)
+RETURNING *;
\ No newline at end of file
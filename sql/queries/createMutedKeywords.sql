@@ -0,0 +1,11 @@
+-- name: CreateMutedKeyword :one
+INSERT INTO muted_keywords (id, user_id, keyword, created_at, expires_at)
+VALUES (
+    gen_random_uuid(),
+    $1,
+    $2,
+    NOW(),
+    $3
+)
+ON CONFLICT (user_id, keyword) DO UPDATE SET expires_at = EXCLUDED.expires_at
+RETURNING *;
\ No newline at end of file
@@ -0,0 +1,18 @@
+-- name: CreateNotification :exec
+WITH notification AS (
+    INSERT INTO notifications (id, user_id, type, chirp_id, created_at, updated_at)
+    SELECT gen_random_uuid(), @user_id::uuid, @type::text, sqlc.narg('chirp_id')::uuid, NOW(), NOW()
+    WHERE NOT EXISTS (
+        SELECT 1
+        FROM mutes
+        WHERE mutes.muter_id = @user_id::uuid
+            AND mutes.muted_id = @actor_id::uuid
+    )
+    ON CONFLICT (user_id, type, COALESCE(chirp_id, '00000000-0000-0000-0000-000000000000'::uuid)) WHERE read_at IS NULL
+    DO UPDATE SET updated_at = NOW()
+    RETURNING id
+)
+INSERT INTO notification_actors (notification_id, actor_id, created_at)
+SELECT notification.id, @actor_id::uuid, NOW()
+FROM notification
+ON CONFLICT (notification_id, actor_id) DO UPDATE SET created_at = NOW();
\ No newline at end of file
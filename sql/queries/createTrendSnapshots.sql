@@ -0,0 +1,16 @@
+-- name: CreateTrendSnapshot :exec
+WITH snapshot AS (
+    INSERT INTO trend_snapshots (id, created_at, window_start)
+    VALUES (gen_random_uuid(), NOW(), @window_start::timestamp)
+    RETURNING id
+)
+INSERT INTO trends (snapshot_id, tag, position, score, chirp_count, author_count, baseline_count)
+SELECT snapshot.id, trend.tag, trend.position, trend.score, trend.chirp_count, trend.author_count, trend.baseline_count
+FROM snapshot
+CROSS JOIN unnest(
+    @tags::text[],
+    @scores::float8[],
+    @chirp_counts::bigint[],
+    @author_counts::bigint[],
+    @baseline_counts::bigint[]
+) WITH ORDINALITY AS trend(tag, score, chirp_count, author_count, baseline_count, position);
\ No newline at end of file
@@ -1,10 +1,11 @@
 -- name: CreateUser :one
-INSERT INTO users (id, created_at, updated_at, email, hashed_password)
+INSERT INTO users (id, created_at, updated_at, email, hashed_password, handle)
 VALUES (
     gen_random_uuid(),
     Now(),
     Now(),
     $1, 
-    $2
+    $2,
+    $3
 )
 RETURNING *;
\ No newline at end of file
@@ -0,0 +1,3 @@
+-- name: DeleteBlobDeletions :exec
+DELETE FROM blob_deletions
+WHERE blob_key = ANY(@blob_keys::text[]);
\ No newline at end of file
@@ -0,0 +1,3 @@
+-- name: DeleteBookmark :execrows
+DELETE FROM bookmarks
+WHERE user_id = $1 AND chirp_id = $2;
\ No newline at end of file
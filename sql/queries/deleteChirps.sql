@@ -1,3 +1,18 @@
--- name: DeleteChirps :exec
-DELETE FROM chirps
-WHERE id = $1;
\ No newline at end of file
+-- name: DeleteChirps :execrows
+WITH target AS (
+    SELECT id, reply_to_id
+    FROM chirps
+    WHERE chirps.id = $1
+        AND published
+        AND deleted_at IS NULL
+    FOR UPDATE
+), replied AS (
+    UPDATE chirps
+    SET reply_count = reply_count - 1
+    FROM target
+    WHERE chirps.id = target.reply_to_id
+)
+UPDATE chirps
+SET deleted_at = NOW(), deletion_reason = $2, updated_at = NOW()
+FROM target
+WHERE chirps.id = target.id;
\ No newline at end of file
@@ -0,0 +1,3 @@
+-- name: DeleteFollowRequest :execrows
+DELETE FROM follow_requests
+WHERE requester_id = $1 AND target_id = $2;
\ No newline at end of file
@@ -0,0 +1,3 @@
+-- name: DeleteHomeTimeline :exec
+DELETE FROM home_timeline_entries
+WHERE user_id = $1;
\ No newline at end of file
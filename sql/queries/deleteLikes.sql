@@ -0,0 +1,10 @@
+-- name: DeleteLike :execrows
+WITH unliked AS (
+    DELETE FROM likes
+    WHERE user_id = $1 AND chirp_id = $2
+    RETURNING chirp_id
+)
+UPDATE chirps
+SET like_count = like_count - 1
+FROM unliked
+WHERE chirps.id = unliked.chirp_id;
\ No newline at end of file
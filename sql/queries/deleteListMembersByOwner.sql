@@ -0,0 +1,6 @@
+-- name: DeleteListMembersByOwner :exec
+DELETE FROM list_members
+USING lists
+WHERE lists.id = list_members.list_id
+    AND lists.user_id = @owner_id
+    AND list_members.user_id = @user_id;
\ No newline at end of file
@@ -0,0 +1,3 @@
+-- name: DeleteList :execrows
+DELETE FROM lists
+WHERE id = $1 AND user_id = $2;
\ No newline at end of file
@@ -0,0 +1,3 @@
+-- name: DeleteMutedKeyword :execrows
+DELETE FROM muted_keywords
+WHERE id = $1 AND user_id = $2;
\ No newline at end of file
@@ -0,0 +1,16 @@
+-- name: DeleteNotificationActor :exec
+WITH removed AS (
+    DELETE FROM notification_actors
+    USING notifications
+    WHERE notifications.id = notification_actors.notification_id
+        AND notifications.user_id = @user_id
+        AND notification_actors.actor_id = @actor_id
+    RETURNING notification_actors.notification_id
+)
+DELETE FROM notifications
+WHERE notifications.id IN (SELECT notification_id FROM removed)
+    AND (
+        SELECT COUNT(*)
+        FROM notification_actors
+        WHERE notification_actors.notification_id = notifications.id
+    ) = 1;
\ No newline at end of file
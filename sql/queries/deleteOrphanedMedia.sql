@@ -0,0 +1,23 @@
+-- name: DeleteOrphanedMedia :execrows
+WITH orphaned AS (
+    SELECT id
+    FROM media_attachments
+    WHERE chirp_id IS NULL
+        AND created_at <= @created_before
+        AND NOT EXISTS (
+            SELECT 1
+            FROM users
+            WHERE users.avatar_media_id = media_attachments.id
+        )
+    LIMIT @row_limit
+    FOR UPDATE SKIP LOCKED
+), queued AS (
+    INSERT INTO blob_deletions (blob_key, created_at)
+    SELECT keys.blob_key, NOW()
+    FROM media_attachments
+    CROSS JOIN LATERAL (VALUES (media_attachments.blob_key), (media_attachments.thumbnail_key)) AS keys(blob_key)
+    WHERE media_attachments.id IN (SELECT id FROM orphaned)
+    ON CONFLICT (blob_key) DO NOTHING
+)
+DELETE FROM media_attachments
+WHERE id IN (SELECT id FROM orphaned);
\ No newline at end of file
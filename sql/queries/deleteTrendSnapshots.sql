@@ -0,0 +1,9 @@
+-- name: DeleteTrendSnapshots :execrows
+DELETE FROM trend_snapshots
+WHERE created_at < @created_before
+    AND id <> (
+        SELECT id
+        FROM trend_snapshots
+        ORDER BY created_at DESC
+        LIMIT 1
+    );
\ No newline at end of file
@@ -0,0 +1,14 @@
+-- name: EnqueueTimelineFanOut :exec
+WITH marked AS (
+    UPDATE chirps
+    SET fanned_out = users.follower_count <= @fan_out_limit
+    FROM users
+    WHERE chirps.id = @chirp_id
+        AND users.id = chirps.user_id
+)
+INSERT INTO timeline_fanouts (chirp_id, created_at)
+VALUES (
+    @chirp_id,
+    NOW()
+)
+ON CONFLICT (chirp_id) DO NOTHING;
\ No newline at end of file
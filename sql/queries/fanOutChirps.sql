@@ -0,0 +1,24 @@
+-- name: FanOutChirps :one
+WITH claimed AS (
+    DELETE FROM timeline_fanouts
+    WHERE chirp_id IN (
+        SELECT chirp_id
+        FROM timeline_fanouts
+        ORDER BY created_at ASC
+        LIMIT @row_limit
+        FOR UPDATE SKIP LOCKED
+    )
+    RETURNING chirp_id
+), entries AS (
+    INSERT INTO home_timeline_entries (user_id, chirp_id, author_id, created_at)
+    SELECT follows.follower_id, chirps.id, follows.followee_id, chirps.created_at
+    FROM claimed
+    INNER JOIN chirps ON chirps.id = claimed.chirp_id
+    INNER JOIN follows ON follows.followee_id = chirps.user_id
+    WHERE chirps.fanned_out
+    ON CONFLICT (user_id, chirp_id) DO NOTHING
+    RETURNING chirp_id
+)
+SELECT
+    (SELECT COUNT(*) FROM claimed) AS chirps,
+    (SELECT COUNT(*) FROM entries) AS entries;
\ No newline at end of file
@@ -0,0 +1,4 @@
+-- name: FlagHashtag :exec
+INSERT INTO flagged_hashtags (tag, created_at, reason)
+VALUES (@tag, NOW(), @reason)
+ON CONFLICT (tag) DO UPDATE SET reason = EXCLUDED.reason;
\ No newline at end of file
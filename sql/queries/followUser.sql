@@ -0,0 +1,15 @@
+-- name: FollowUser :execrows
+WITH followed AS (
+    INSERT INTO follows (follower_id, followee_id, created_at)
+    VALUES (
+        $1,
+        $2,
+        NOW()
+    )
+    ON CONFLICT (follower_id, followee_id) DO NOTHING
+    RETURNING followee_id
+)
+UPDATE users
+SET follower_count = follower_count + 1
+FROM followed
+WHERE users.id = followed.followee_id;
\ No newline at end of file
@@ -0,0 +1,19 @@
+-- name: ListChirpsAsc :many
+SELECT *
+FROM chirps
+WHERE chirp_visible_to(chirps, sqlc.narg('viewer_id')::uuid, false)
+    AND (cardinality(@author_ids::uuid[]) = 0 OR user_id = ANY(@author_ids::uuid[]))
+    AND (sqlc.narg('created_after')::timestamp IS NULL OR created_at > sqlc.narg('created_after')::timestamp)
+    AND (sqlc.narg('created_before')::timestamp IS NULL OR created_at < sqlc.narg('created_before')::timestamp)
+    AND (
+        sqlc.narg('cursor_created_at')::timestamp IS NULL
+        OR (created_at, id) > (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::uuid)
+    )
+    AND NOT (id = ANY(@exclude_ids::uuid[]))
+    AND NOT (@exclude_sensitive::boolean AND sensitive)
+    AND (sqlc.narg('reply_to_id')::uuid IS NULL OR reply_to_id = sqlc.narg('reply_to_id')::uuid)
+    AND (sqlc.narg('is_reply')::boolean IS NULL OR (reply_to_id IS NOT NULL) = sqlc.narg('is_reply')::boolean)
+    AND (sqlc.narg('has_replies')::boolean IS NULL OR (reply_count > 0) = sqlc.narg('has_replies')::boolean)
+    AND like_count >= @min_likes::integer
+ORDER BY created_at ASC, id ASC
+LIMIT @row_limit;
\ No newline at end of file
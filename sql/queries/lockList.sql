@@ -0,0 +1,5 @@
+-- name: LockList :exec
+SELECT id
+FROM lists
+WHERE id = $1
+FOR NO KEY UPDATE;
\ No newline at end of file
@@ -0,0 +1,6 @@
+-- name: LockUsers :exec
+SELECT id
+FROM users
+WHERE id = ANY(@user_ids::uuid[])
+ORDER BY id
+FOR NO KEY UPDATE;
\ No newline at end of file
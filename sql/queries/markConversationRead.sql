@@ -0,0 +1,5 @@
+-- name: MarkConversationRead :exec
+UPDATE conversation_participants
+SET last_read_at = GREATEST(last_read_at, @read_at::timestamp)
+WHERE conversation_id = @conversation_id
+    AND user_id = @user_id;
\ No newline at end of file
@@ -0,0 +1,43 @@
+-- name: NotifyMentions :one
+WITH pending AS (
+    SELECT chirp_mentions.chirp_id, chirp_mentions.user_id, chirps.user_id AS actor_id
+    FROM chirp_mentions
+    INNER JOIN chirps ON chirps.id = chirp_mentions.chirp_id
+    WHERE chirp_mentions.notified_at IS NULL
+        AND chirps.published
+    ORDER BY chirp_mentions.created_at ASC
+    LIMIT @row_limit
+    FOR UPDATE OF chirp_mentions SKIP LOCKED
+), marked AS (
+    UPDATE chirp_mentions
+    SET notified_at = NOW()
+    FROM pending
+    WHERE chirp_mentions.chirp_id = pending.chirp_id
+        AND chirp_mentions.user_id = pending.user_id
+), notified AS (
+    INSERT INTO notifications (id, user_id, type, chirp_id, created_at, updated_at)
+    SELECT gen_random_uuid(), pending.user_id, 'mention', pending.chirp_id, NOW(), NOW()
+    FROM pending
+    INNER JOIN chirps ON chirps.id = pending.chirp_id
+    WHERE pending.actor_id IS NOT NULL
+        AND pending.actor_id <> pending.user_id
+        AND chirp_visible_to(chirps, pending.user_id, true)
+        AND NOT EXISTS (
+            SELECT 1
+            FROM mutes
+            WHERE mutes.muter_id = pending.user_id
+                AND mutes.muted_id = pending.actor_id
+        )
+    ON CONFLICT (user_id, type, COALESCE(chirp_id, '00000000-0000-0000-0000-000000000000'::uuid)) WHERE read_at IS NULL
+    DO UPDATE SET updated_at = NOW()
+    RETURNING id, user_id, chirp_id
+), actors AS (
+    INSERT INTO notification_actors (notification_id, actor_id, created_at)
+    SELECT notified.id, pending.actor_id, NOW()
+    FROM notified
+    INNER JOIN pending ON pending.chirp_id = notified.chirp_id
+        AND pending.user_id = notified.user_id
+    ON CONFLICT (notification_id, actor_id) DO NOTHING
+)
+SELECT COUNT(*) AS mentions
+FROM pending;
\ No newline at end of file
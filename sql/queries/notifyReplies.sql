@@ -0,0 +1,51 @@
+-- name: NotifyReplies :one
+WITH pending AS (
+    SELECT id, reply_to_id
+    FROM chirps
+    WHERE reply_to_id IS NOT NULL
+        AND reply_notified_at IS NULL
+        AND published
+    ORDER BY created_at ASC
+    LIMIT @row_limit
+    FOR UPDATE SKIP LOCKED
+), marked AS (
+    UPDATE chirps
+    SET reply_notified_at = NOW()
+    FROM pending
+    WHERE chirps.id = pending.id
+), replies AS (
+    SELECT parents.id AS chirp_id, parents.user_id, chirps.user_id AS actor_id
+    FROM pending
+    INNER JOIN chirps ON chirps.id = pending.id
+    INNER JOIN chirps AS parents ON parents.id = pending.reply_to_id
+    WHERE chirps.user_id IS NOT NULL
+        AND parents.user_id IS NOT NULL
+        AND chirps.user_id <> parents.user_id
+        AND chirp_visible_to(chirps, parents.user_id, true)
+        AND NOT EXISTS (
+            SELECT 1
+            FROM mutes
+            WHERE mutes.muter_id = parents.user_id
+                AND mutes.muted_id = chirps.user_id
+        )
+), notified AS (
+    -- Several replies to one chirp in a batch share a notification, so each
+    -- group is inserted once.
+    INSERT INTO notifications (id, user_id, type, chirp_id, created_at, updated_at)
+    SELECT gen_random_uuid(), groups.user_id, 'reply', groups.chirp_id, NOW(), NOW()
+    FROM (
+        SELECT DISTINCT replies.user_id, replies.chirp_id
+        FROM replies
+    ) AS groups
+    ON CONFLICT (user_id, type, COALESCE(chirp_id, '00000000-0000-0000-0000-000000000000'::uuid)) WHERE read_at IS NULL
+    DO UPDATE SET updated_at = NOW()
+    RETURNING id, chirp_id
+), actors AS (
+    INSERT INTO notification_actors (notification_id, actor_id, created_at)
+    SELECT DISTINCT notified.id, replies.actor_id, NOW()
+    FROM notified
+    INNER JOIN replies ON replies.chirp_id = notified.chirp_id
+    ON CONFLICT (notification_id, actor_id) DO UPDATE SET created_at = NOW()
+)
+SELECT COUNT(*) AS replies
+FROM pending;
\ No newline at end of file
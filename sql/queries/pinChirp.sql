@@ -0,0 +1,8 @@
+-- name: PinChirp :exec
+INSERT INTO pinned_chirps (user_id, chirp_id, created_at)
+VALUES (
+    $1,
+    $2,
+    NOW()
+)
+ON CONFLICT (user_id, chirp_id) DO NOTHING;
\ No newline at end of file
@@ -0,0 +1,36 @@
+-- name: PublishDueChirps :many
+WITH published AS (
+    UPDATE chirps
+    SET published = true, created_at = NOW(), updated_at = NOW(), fanned_out = COALESCE((
+        SELECT users.follower_count <= @fan_out_limit
+        FROM users
+        WHERE users.id = chirps.user_id
+    ), false)
+    WHERE id IN (
+        SELECT id
+        FROM chirps
+        WHERE NOT published
+            AND publish_at <= NOW()
+        ORDER BY publish_at ASC
+        LIMIT @row_limit
+        FOR UPDATE SKIP LOCKED
+    )
+        AND NOT published
+    RETURNING *
+), queued AS (
+    INSERT INTO timeline_fanouts (chirp_id, created_at)
+    SELECT id, NOW()
+    FROM published
+), replied AS (
+    UPDATE chirps
+    SET reply_count = reply_count + counts.replies
+    FROM (
+        SELECT reply_to_id, COUNT(*) AS replies
+        FROM published
+        WHERE reply_to_id IS NOT NULL
+        GROUP BY reply_to_id
+    ) AS counts
+    WHERE chirps.id = counts.reply_to_id
+)
+SELECT *
+FROM published;
\ No newline at end of file
@@ -0,0 +1,17 @@
+-- name: PurgeDeletedChirps :execrows
+WITH purgeable AS (
+    SELECT id
+    FROM chirps
+    WHERE deleted_at <= @deleted_before
+    LIMIT @row_limit
+    FOR UPDATE SKIP LOCKED
+), queued AS (
+    INSERT INTO blob_deletions (blob_key, created_at)
+    SELECT keys.blob_key, NOW()
+    FROM media_attachments
+    CROSS JOIN LATERAL (VALUES (media_attachments.blob_key), (media_attachments.thumbnail_key)) AS keys(blob_key)
+    WHERE media_attachments.chirp_id IN (SELECT id FROM purgeable)
+    ON CONFLICT (blob_key) DO NOTHING
+)
+DELETE FROM chirps
+WHERE id IN (SELECT id FROM purgeable);
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- name: ReadAuthorSummaries :many
+SELECT
+    users.id,
+    users.handle,
+    users.display_name,
+    media_attachments.blob_key AS avatar_blob_key,
+    media_attachments.thumbnail_key AS avatar_thumbnail_key
+FROM users
+LEFT JOIN media_attachments ON media_attachments.id = users.avatar_media_id
+WHERE users.id = ANY(@user_ids::uuid[]);
\ No newline at end of file
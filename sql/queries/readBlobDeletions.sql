@@ -0,0 +1,5 @@
+-- name: ReadBlobDeletions :many
+SELECT blob_key
+FROM blob_deletions
+ORDER BY created_at ASC
+LIMIT $1;
\ No newline at end of file
@@ -0,0 +1,7 @@
+-- name: ReadBlockExists :one
+SELECT EXISTS (
+    SELECT 1
+    FROM blocks
+    WHERE (blocker_id = @user_id AND blocked_id = @other_user_id)
+        OR (blocker_id = @other_user_id AND blocked_id = @user_id)
+) AS block_exists;
\ No newline at end of file
@@ -0,0 +1,8 @@
+-- name: ReadBlockedUserIDs :many
+SELECT blocked_id AS user_id
+FROM blocks
+WHERE blocker_id = @user_id AND blocked_id = ANY(@user_ids::uuid[])
+UNION
+SELECT blocker_id AS user_id
+FROM blocks
+WHERE blocked_id = @user_id AND blocker_id = ANY(@user_ids::uuid[]);
\ No newline at end of file
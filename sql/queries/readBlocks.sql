@@ -0,0 +1,6 @@
+-- name: ReadBlocks :many
+SELECT users.id, users.handle, blocks.created_at
+FROM blocks
+INNER JOIN users ON users.id = blocks.blocked_id
+WHERE blocks.blocker_id = $1
+ORDER BY blocks.created_at DESC;
\ No newline at end of file
@@ -0,0 +1,12 @@
+-- name: ReadBookmarkedChirps :many
+SELECT chirps.*, bookmarks.created_at AS bookmarked_at
+FROM bookmarks
+INNER JOIN chirps ON chirps.id = bookmarks.chirp_id
+WHERE bookmarks.user_id = @user_id
+    AND chirp_visible_to(chirps, @user_id, true)
+    AND (
+        sqlc.narg('cursor_bookmarked_at')::timestamp IS NULL
+        OR (bookmarks.created_at, bookmarks.chirp_id) < (sqlc.narg('cursor_bookmarked_at')::timestamp, sqlc.narg('cursor_chirp_id')::uuid)
+    )
+ORDER BY bookmarks.created_at DESC, bookmarks.chirp_id DESC
+LIMIT @row_limit;
\ No newline at end of file
@@ -1,4 +0,0 @@
--- name: ReadChirps :many
-SELECT * 
-FROM chirps
-ORDER BY created_at ASC;
\ No newline at end of file
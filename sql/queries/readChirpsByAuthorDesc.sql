@@ -1,4 +0,0 @@
--- name: ReadChirpsByAuthorDesc :many
-SELECT * FROM chirps
-WHERE user_id = $1
-ORDER BY created_at DESC;
\ No newline at end of file
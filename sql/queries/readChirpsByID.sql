@@ -1,4 +1,7 @@
 -- name: ReadChirpsByID :one
-SELECT *
+SELECT
+    sqlc.embed(chirps),
+    chirp_visible_to(chirps, sqlc.narg('viewer_id')::uuid, true)::boolean AS visible,
+    chirp_audience_includes(chirps, sqlc.narg('viewer_id')::uuid, true)::boolean AS in_audience
 FROM chirps
-WHERE id = $1;
\ No newline at end of file
+WHERE id = @id;
\ No newline at end of file
@@ -1,3 +0,0 @@
--- name: ReadChirpsDesc :many
-SELECT * FROM chirps
-ORDER BY created_at DESC;
\ No newline at end of file
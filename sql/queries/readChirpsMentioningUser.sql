@@ -0,0 +1,7 @@
+-- name: ReadChirpsMentioningUser :many
+SELECT chirps.*
+FROM chirps
+INNER JOIN chirp_mentions ON chirps.id = chirp_mentions.chirp_id
+WHERE chirp_mentions.user_id = $1
+    AND chirp_visible_to(chirps, $1, false)
+ORDER BY chirps.created_at DESC;
\ No newline at end of file
@@ -0,0 +1,4 @@
+-- name: ReadChirpyRed :one
+SELECT is_chirpy_red
+FROM users
+WHERE id = $1;
\ No newline at end of file
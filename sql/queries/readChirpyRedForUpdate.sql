@@ -0,0 +1,5 @@
+-- name: ReadChirpyRedForUpdate :one
+SELECT is_chirpy_red
+FROM users
+WHERE id = $1
+FOR UPDATE;
\ No newline at end of file
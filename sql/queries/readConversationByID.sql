@@ -0,0 +1,14 @@
+-- name: ReadConversationByID :one
+SELECT
+    sqlc.embed(conversations),
+    (
+        SELECT COUNT(*)
+        FROM messages
+        WHERE messages.conversation_id = conversations.id
+            AND messages.sender_id IS DISTINCT FROM @user_id::uuid
+            AND (conversation_participants.last_read_at IS NULL OR messages.created_at > conversation_participants.last_read_at)
+    ) AS unread_count
+FROM conversations
+INNER JOIN conversation_participants ON conversation_participants.conversation_id = conversations.id
+WHERE conversations.id = @id
+    AND conversation_participants.user_id = @user_id::uuid;
\ No newline at end of file
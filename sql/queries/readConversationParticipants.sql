@@ -0,0 +1,6 @@
+-- name: ReadConversationParticipants :many
+SELECT conversation_participants.conversation_id, users.id, users.handle, conversation_participants.last_read_at
+FROM conversation_participants
+INNER JOIN users ON users.id = conversation_participants.user_id
+WHERE conversation_participants.conversation_id = ANY(@conversation_ids::uuid[])
+ORDER BY conversation_participants.joined_at ASC, users.id ASC;
\ No newline at end of file
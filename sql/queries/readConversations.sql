@@ -0,0 +1,19 @@
+-- name: ReadConversations :many
+SELECT
+    sqlc.embed(conversations),
+    (
+        SELECT COUNT(*)
+        FROM messages
+        WHERE messages.conversation_id = conversations.id
+            AND messages.sender_id IS DISTINCT FROM @user_id::uuid
+            AND (conversation_participants.last_read_at IS NULL OR messages.created_at > conversation_participants.last_read_at)
+    ) AS unread_count
+FROM conversations
+INNER JOIN conversation_participants ON conversation_participants.conversation_id = conversations.id
+WHERE conversation_participants.user_id = @user_id::uuid
+    AND (
+        sqlc.narg('cursor_updated_at')::timestamp IS NULL
+        OR (conversations.updated_at, conversations.id) < (sqlc.narg('cursor_updated_at')::timestamp, sqlc.narg('cursor_id')::uuid)
+    )
+ORDER BY conversations.updated_at DESC, conversations.id DESC
+LIMIT @row_limit;
\ No newline at end of file
@@ -0,0 +1,7 @@
+-- name: ReadDirectConversation :one
+SELECT *
+FROM conversations
+WHERE LEAST(direct_user_a, direct_user_b) = LEAST(@user_id::uuid, @other_user_id::uuid)
+    AND GREATEST(direct_user_a, direct_user_b) = GREATEST(@user_id::uuid, @other_user_id::uuid)
+    AND direct_user_a IS NOT NULL
+    AND direct_user_b IS NOT NULL;
\ No newline at end of file
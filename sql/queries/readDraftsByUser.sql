@@ -0,0 +1,5 @@
+-- name: ReadDraftsByUser :many
+SELECT *
+FROM drafts
+WHERE user_id = $1
+ORDER BY updated_at DESC;
\ No newline at end of file
@@ -0,0 +1,4 @@
+-- name: ReadExistingUserIDs :many
+SELECT id
+FROM users
+WHERE id = ANY(@user_ids::uuid[]);
\ No newline at end of file
@@ -0,0 +1,4 @@
+-- name: ReadFlaggedHashtags :many
+SELECT *
+FROM flagged_hashtags
+ORDER BY tag;
\ No newline at end of file
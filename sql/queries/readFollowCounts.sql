@@ -0,0 +1,6 @@
+-- name: ReadFollowCounts :one
+SELECT
+    follower_count AS followers,
+    (SELECT COUNT(*) FROM follows WHERE follows.follower_id = users.id) AS following
+FROM users
+WHERE users.id = $1;
\ No newline at end of file
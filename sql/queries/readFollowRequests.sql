@@ -0,0 +1,11 @@
+-- name: ReadFollowRequests :many
+SELECT users.id, users.handle, follow_requests.created_at AS requested_at
+FROM follow_requests
+INNER JOIN users ON users.id = follow_requests.requester_id
+WHERE follow_requests.target_id = @user_id
+    AND (
+        sqlc.narg('cursor_requested_at')::timestamp IS NULL
+        OR (follow_requests.created_at, follow_requests.requester_id) < (sqlc.narg('cursor_requested_at')::timestamp, sqlc.narg('cursor_user_id')::uuid)
+    )
+ORDER BY follow_requests.created_at DESC, follow_requests.requester_id DESC
+LIMIT @row_limit;
\ No newline at end of file
@@ -0,0 +1,24 @@
+-- name: ReadFollowSuggestions :many
+SELECT follow_suggestions.*
+FROM follow_suggestions
+WHERE follow_suggestions.user_id = @user_id
+    AND NOT EXISTS (
+        SELECT 1
+        FROM follows
+        WHERE follows.follower_id = follow_suggestions.user_id
+            AND follows.followee_id = follow_suggestions.suggested_id
+    )
+    AND NOT EXISTS (
+        SELECT 1
+        FROM blocks
+        WHERE (blocks.blocker_id = follow_suggestions.user_id AND blocks.blocked_id = follow_suggestions.suggested_id)
+            OR (blocks.blocker_id = follow_suggestions.suggested_id AND blocks.blocked_id = follow_suggestions.user_id)
+    )
+    AND NOT EXISTS (
+        SELECT 1
+        FROM mutes
+        WHERE mutes.muter_id = follow_suggestions.user_id
+            AND mutes.muted_id = follow_suggestions.suggested_id
+    )
+ORDER BY follow_suggestions.score DESC, follow_suggestions.suggested_id
+LIMIT @row_limit;
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- name: ReadFollowTarget :one
+SELECT
+    users.protected,
+    EXISTS (
+        SELECT 1
+        FROM follows
+        WHERE follows.follower_id = @follower_id::uuid
+            AND follows.followee_id = users.id
+    ) AS following
+FROM users
+WHERE users.id = @followee_id::uuid;
\ No newline at end of file
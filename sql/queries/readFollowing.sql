@@ -0,0 +1,11 @@
+-- name: ReadFollowing :many
+SELECT users.id, users.handle, follows.created_at AS followed_at
+FROM follows
+INNER JOIN users ON users.id = follows.followee_id
+WHERE follows.follower_id = @user_id
+    AND (
+        sqlc.narg('cursor_followed_at')::timestamp IS NULL
+        OR (follows.created_at, follows.followee_id) < (sqlc.narg('cursor_followed_at')::timestamp, sqlc.narg('cursor_user_id')::uuid)
+    )
+ORDER BY follows.created_at DESC, follows.followee_id DESC
+LIMIT @row_limit;
\ No newline at end of file
@@ -0,0 +1,36 @@
+-- name: ReadHashtagActivity :many
+WITH tagged AS (
+    SELECT chirp_hashtags.tag, chirps.user_id, chirps.created_at
+    FROM chirp_hashtags
+    INNER JOIN chirps ON chirps.id = chirp_hashtags.chirp_id
+    WHERE chirps.created_at >= @baseline_start::timestamp
+        AND chirp_visible_to(chirps, NULL, false)
+        AND NOT EXISTS (
+            SELECT 1
+            FROM flagged_hashtags
+            WHERE flagged_hashtags.tag = chirp_hashtags.tag
+        )
+), authors AS (
+    SELECT
+        tag,
+        user_id,
+        COUNT(*) AS chirp_count,
+        ROW_NUMBER() OVER (PARTITION BY tag ORDER BY COUNT(*) DESC, user_id) AS position
+    FROM tagged
+    WHERE created_at >= @window_start::timestamp
+    GROUP BY tag, user_id
+), baseline AS (
+    SELECT tag, COUNT(*) AS chirp_count
+    FROM tagged
+    WHERE created_at < @window_start::timestamp
+    GROUP BY tag
+)
+SELECT
+    authors.tag,
+    SUM(authors.chirp_count)::bigint AS chirp_count,
+    COUNT(*) AS author_count,
+    (SUM(authors.chirp_count) FILTER (WHERE authors.position <= @top_authors::bigint))::bigint AS top_author_chirp_count,
+    COALESCE(MAX(baseline.chirp_count), 0)::bigint AS baseline_count
+FROM authors
+LEFT JOIN baseline ON baseline.tag = authors.tag
+GROUP BY authors.tag;
\ No newline at end of file
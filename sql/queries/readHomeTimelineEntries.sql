@@ -0,0 +1,13 @@
+-- name: ReadHomeTimelineEntries :many
+SELECT chirps.*
+FROM home_timeline_entries
+INNER JOIN chirps ON chirps.id = home_timeline_entries.chirp_id
+WHERE home_timeline_entries.user_id = @viewer_id::uuid
+    AND chirp_visible_to(chirps, @viewer_id::uuid, false)
+    AND (
+        sqlc.narg('cursor_created_at')::timestamp IS NULL
+        OR (home_timeline_entries.created_at, home_timeline_entries.chirp_id) < (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::uuid)
+    )
+    AND NOT (@exclude_sensitive::boolean AND chirps.sensitive)
+ORDER BY home_timeline_entries.created_at DESC, home_timeline_entries.chirp_id DESC
+LIMIT @row_limit;
\ No newline at end of file
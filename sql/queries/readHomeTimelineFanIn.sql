@@ -0,0 +1,22 @@
+-- name: ReadHomeTimelineFanIn :many
+SELECT *
+FROM chirps
+WHERE (
+        chirps.user_id = @viewer_id::uuid
+        OR (
+            NOT chirps.fanned_out
+            AND chirps.user_id IN (
+                SELECT follows.followee_id
+                FROM follows
+                WHERE follows.follower_id = @viewer_id::uuid
+            )
+        )
+    )
+    AND chirp_visible_to(chirps, @viewer_id::uuid, false)
+    AND (
+        sqlc.narg('cursor_created_at')::timestamp IS NULL
+        OR (chirps.created_at, chirps.id) < (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::uuid)
+    )
+    AND NOT (@exclude_sensitive::boolean AND chirps.sensitive)
+ORDER BY chirps.created_at DESC, chirps.id DESC
+LIMIT @row_limit;
\ No newline at end of file
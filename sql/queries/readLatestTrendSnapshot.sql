@@ -0,0 +1,5 @@
+-- name: ReadLatestTrendSnapshot :one
+SELECT *
+FROM trend_snapshots
+ORDER BY created_at DESC
+LIMIT 1;
\ No newline at end of file
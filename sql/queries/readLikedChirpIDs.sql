@@ -0,0 +1,5 @@
+-- name: ReadLikedChirpIDs :many
+SELECT chirp_id
+FROM likes
+WHERE user_id = @user_id
+    AND chirp_id = ANY(@chirp_ids::uuid[]);
\ No newline at end of file
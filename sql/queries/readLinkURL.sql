@@ -0,0 +1,4 @@
+-- name: ReadLinkURL :one
+SELECT url
+FROM links
+WHERE code = $1;
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- name: ReadLinksByChirpIDs :many
+SELECT chirp_links.chirp_id, links.code, links.url
+FROM chirp_links
+JOIN links ON links.id = chirp_links.link_id
+WHERE chirp_links.chirp_id = ANY(@chirp_ids::uuid[]);
\ No newline at end of file
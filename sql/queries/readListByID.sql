@@ -0,0 +1,22 @@
+-- name: ReadListByID :one
+SELECT
+    sqlc.embed(lists),
+    (
+        SELECT COUNT(*)
+        FROM list_members
+        WHERE list_members.list_id = lists.id
+    ) AS member_count
+FROM lists
+WHERE lists.id = @id
+    AND (
+        lists.user_id = sqlc.narg('viewer_id')::uuid
+        OR (
+            lists.visibility = 'public'
+            AND NOT EXISTS (
+                SELECT 1
+                FROM blocks
+                WHERE (blocks.blocker_id = lists.user_id AND blocks.blocked_id = sqlc.narg('viewer_id')::uuid)
+                    OR (blocks.blocker_id = sqlc.narg('viewer_id')::uuid AND blocks.blocked_id = lists.user_id)
+            )
+        )
+    );
\ No newline at end of file
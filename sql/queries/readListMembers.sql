@@ -0,0 +1,6 @@
+-- name: ReadListMembers :many
+SELECT users.id, users.handle, list_members.created_at
+FROM list_members
+INNER JOIN users ON users.id = list_members.user_id
+WHERE list_members.list_id = $1
+ORDER BY list_members.created_at DESC;
\ No newline at end of file
@@ -0,0 +1,13 @@
+-- name: ReadListTimeline :many
+SELECT chirps.*
+FROM chirps
+INNER JOIN list_members ON list_members.user_id = chirps.user_id
+WHERE list_members.list_id = @list_id
+    AND chirp_visible_to(chirps, sqlc.narg('viewer_id')::uuid, false)
+    AND (
+        sqlc.narg('cursor_created_at')::timestamp IS NULL
+        OR (chirps.created_at, chirps.id) < (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::uuid)
+    )
+    AND NOT (@exclude_sensitive::boolean AND chirps.sensitive)
+ORDER BY chirps.created_at DESC, chirps.id DESC
+LIMIT @row_limit;
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- name: ReadListsByUser :many
+SELECT
+    sqlc.embed(lists),
+    (
+        SELECT COUNT(*)
+        FROM list_members
+        WHERE list_members.list_id = lists.id
+    ) AS member_count
+FROM lists
+WHERE lists.user_id = @user_id
+ORDER BY lists.created_at DESC;
\ No newline at end of file
@@ -0,0 +1,4 @@
+-- name: ReadMediaAttachmentByID :one
+SELECT *
+FROM media_attachments
+WHERE id = $1 AND user_id = $2;
\ No newline at end of file
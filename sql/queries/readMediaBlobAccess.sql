@@ -0,0 +1,13 @@
+-- name: ReadMediaBlobAccess :one
+SELECT
+    (
+        (sqlc.narg('viewer_id')::uuid IS NOT NULL AND media_attachments.user_id = sqlc.narg('viewer_id')::uuid)
+        OR COALESCE(chirp_visible_to(chirps, sqlc.narg('viewer_id')::uuid, true), false)
+        OR users.id IS NOT NULL
+    )::boolean AS visible
+FROM media_attachments
+LEFT JOIN chirps ON chirps.id = media_attachments.chirp_id
+LEFT JOIN users ON users.avatar_media_id = media_attachments.id
+WHERE media_attachments.blob_key = @blob_key
+    OR media_attachments.thumbnail_key = @blob_key
+LIMIT 1;
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- name: ReadMediaByChirpIDs :many
+SELECT *
+FROM media_attachments
+WHERE chirp_id = ANY(@chirp_ids::uuid[])
+ORDER BY chirp_id, position ASC;
\ No newline at end of file
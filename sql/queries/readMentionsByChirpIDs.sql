@@ -0,0 +1,4 @@
+-- name: ReadMentionsByChirpIDs :many
+SELECT *
+FROM chirp_mentions
+WHERE chirp_id = ANY(@chirp_ids::uuid[]);
\ No newline at end of file
@@ -0,0 +1,4 @@
+-- name: ReadMessageByID :one
+SELECT *
+FROM messages
+WHERE id = $1 AND conversation_id = $2;
\ No newline at end of file
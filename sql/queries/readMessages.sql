@@ -0,0 +1,10 @@
+-- name: ReadMessages :many
+SELECT *
+FROM messages
+WHERE conversation_id = @conversation_id
+    AND (
+        sqlc.narg('cursor_created_at')::timestamp IS NULL
+        OR (created_at, id) < (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::uuid)
+    )
+ORDER BY created_at DESC, id DESC
+LIMIT @row_limit;
\ No newline at end of file
@@ -0,0 +1,6 @@
+-- name: ReadMutedKeywords :many
+SELECT *
+FROM muted_keywords
+WHERE user_id = $1
+    AND (expires_at IS NULL OR expires_at > NOW())
+ORDER BY created_at DESC;
\ No newline at end of file
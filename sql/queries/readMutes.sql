@@ -0,0 +1,6 @@
+-- name: ReadMutes :many
+SELECT users.id, users.handle, mutes.created_at
+FROM mutes
+INNER JOIN users ON users.id = mutes.muted_id
+WHERE mutes.muter_id = $1
+ORDER BY mutes.created_at DESC;
\ No newline at end of file
@@ -0,0 +1,18 @@
+-- name: ReadNotificationActors :many
+SELECT notification_id, id, handle, created_at
+FROM (
+    SELECT
+        notification_actors.notification_id,
+        users.id,
+        users.handle,
+        notification_actors.created_at,
+        ROW_NUMBER() OVER (
+            PARTITION BY notification_actors.notification_id
+            ORDER BY notification_actors.created_at DESC
+        ) AS position
+    FROM notification_actors
+    INNER JOIN users ON users.id = notification_actors.actor_id
+    WHERE notification_actors.notification_id = ANY(@notification_ids::uuid[])
+) AS ranked
+WHERE position <= @actors_per_notification
+ORDER BY notification_id, created_at DESC;
\ No newline at end of file
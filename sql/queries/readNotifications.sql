@@ -0,0 +1,19 @@
+-- name: ReadNotifications :many
+SELECT
+    sqlc.embed(notifications),
+    (
+        SELECT COUNT(*)
+        FROM notification_actors
+        WHERE notification_actors.notification_id = notifications.id
+    ) AS actor_count
+FROM notifications
+LEFT JOIN chirps ON chirps.id = notifications.chirp_id
+WHERE notifications.user_id = @user_id
+    AND (cardinality(@types::text[]) = 0 OR notifications.type = ANY(@types::text[]))
+    AND (notifications.chirp_id IS NULL OR chirp_visible_to(chirps, @user_id, true))
+    AND (
+        sqlc.narg('cursor_updated_at')::timestamp IS NULL
+        OR (notifications.updated_at, notifications.id) < (sqlc.narg('cursor_updated_at')::timestamp, sqlc.narg('cursor_id')::uuid)
+    )
+ORDER BY notifications.updated_at DESC, notifications.id DESC
+LIMIT @row_limit;
\ No newline at end of file
@@ -0,0 +1,4 @@
+-- name: ReadPinnedChirpIDs :many
+SELECT chirp_id
+FROM pinned_chirps
+WHERE chirp_id = ANY(@chirp_ids::uuid[]);
\ No newline at end of file
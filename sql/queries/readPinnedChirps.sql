@@ -0,0 +1,7 @@
+-- name: ReadPinnedChirps :many
+SELECT chirps.*
+FROM chirps
+INNER JOIN pinned_chirps ON pinned_chirps.chirp_id = chirps.id
+WHERE pinned_chirps.user_id = @user_id
+    AND chirp_visible_to(chirps, sqlc.narg('viewer_id')::uuid, false)
+ORDER BY pinned_chirps.created_at DESC;
\ No newline at end of file
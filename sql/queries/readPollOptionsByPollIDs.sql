@@ -0,0 +1,7 @@
+-- name: ReadPollOptionsByPollIDs :many
+SELECT poll_options.*, COUNT(poll_votes.user_id) AS votes
+FROM poll_options
+LEFT JOIN poll_votes ON poll_votes.option_id = poll_options.id
+WHERE poll_options.poll_id = ANY(@poll_ids::uuid[])
+GROUP BY poll_options.id
+ORDER BY poll_options.poll_id, poll_options.position ASC;
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- name: ReadPollVotesByUser :many
+SELECT *
+FROM poll_votes
+WHERE poll_id = ANY(@poll_ids::uuid[])
+    AND user_id = @user_id;
\ No newline at end of file
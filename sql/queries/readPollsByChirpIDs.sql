@@ -0,0 +1,4 @@
+-- name: ReadPollsByChirpIDs :many
+SELECT *
+FROM polls
+WHERE chirp_id = ANY(@chirp_ids::uuid[]);
\ No newline at end of file
@@ -0,0 +1,6 @@
+-- name: ReadScheduledChirps :many
+SELECT *
+FROM chirps
+WHERE user_id = $1
+    AND NOT published
+ORDER BY publish_at ASC;
\ No newline at end of file
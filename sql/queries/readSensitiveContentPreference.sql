@@ -0,0 +1,4 @@
+-- name: ReadSensitiveContentPreference :one
+SELECT sensitive_content
+FROM users
+WHERE id = $1;
\ No newline at end of file
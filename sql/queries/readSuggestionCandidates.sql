@@ -0,0 +1,62 @@
+-- name: ReadSuggestionCandidates :many
+WITH mutuals AS (
+    SELECT second.followee_id AS user_id, COUNT(*) AS mutual_count
+    FROM follows AS first
+    INNER JOIN follows AS second ON second.follower_id = first.followee_id
+    WHERE first.follower_id = @user_id::uuid
+    GROUP BY second.followee_id
+), interests AS (
+    SELECT DISTINCT chirp_hashtags.tag
+    FROM chirps
+    INNER JOIN chirp_hashtags ON chirp_hashtags.chirp_id = chirps.id
+    WHERE chirps.user_id = @user_id::uuid
+        AND chirps.created_at >= @active_since::timestamp
+        AND chirps.deleted_at IS NULL
+), shared AS (
+    SELECT chirps.user_id, COUNT(DISTINCT chirp_hashtags.tag) AS shared_hashtag_count
+    FROM interests
+    INNER JOIN chirp_hashtags ON chirp_hashtags.tag = interests.tag
+    INNER JOIN chirps ON chirps.id = chirp_hashtags.chirp_id
+    WHERE chirps.user_id IS NOT NULL
+        AND chirps.created_at >= @active_since::timestamp
+        AND chirp_visible_to(chirps, NULL, false)
+    GROUP BY chirps.user_id
+), candidates AS (
+    SELECT
+        COALESCE(mutuals.user_id, shared.user_id) AS user_id,
+        COALESCE(mutuals.mutual_count, 0) AS mutual_count,
+        COALESCE(shared.shared_hashtag_count, 0) AS shared_hashtag_count
+    FROM mutuals
+    FULL OUTER JOIN shared ON shared.user_id = mutuals.user_id
+)
+SELECT
+    candidates.user_id::uuid AS user_id,
+    candidates.mutual_count::bigint AS mutual_count,
+    candidates.shared_hashtag_count::bigint AS shared_hashtag_count,
+    (
+        SELECT COUNT(*)
+        FROM chirps
+        WHERE chirps.user_id = candidates.user_id
+            AND chirps.created_at >= @active_since::timestamp
+            AND chirp_visible_to(chirps, NULL, false)
+    )::bigint AS recent_chirp_count
+FROM candidates
+WHERE candidates.user_id <> @user_id::uuid
+    AND NOT EXISTS (
+        SELECT 1
+        FROM follows
+        WHERE follows.follower_id = @user_id::uuid
+            AND follows.followee_id = candidates.user_id
+    )
+    AND NOT EXISTS (
+        SELECT 1
+        FROM blocks
+        WHERE (blocks.blocker_id = @user_id::uuid AND blocks.blocked_id = candidates.user_id)
+            OR (blocks.blocker_id = candidates.user_id AND blocks.blocked_id = @user_id::uuid)
+    )
+    AND NOT EXISTS (
+        SELECT 1
+        FROM mutes
+        WHERE mutes.muter_id = @user_id::uuid
+            AND mutes.muted_id = candidates.user_id
+    );
\ No newline at end of file
@@ -0,0 +1,20 @@
+-- name: ReadTrendSampleChirps :many
+SELECT sqlc.embed(chirps), sample.tag
+FROM (
+    SELECT
+        chirp_hashtags.tag,
+        chirp_hashtags.chirp_id,
+        ROW_NUMBER() OVER (
+            PARTITION BY chirp_hashtags.tag
+            ORDER BY chirps.created_at DESC, chirps.id DESC
+        ) AS position
+    FROM chirp_hashtags
+    INNER JOIN chirps ON chirps.id = chirp_hashtags.chirp_id
+    WHERE chirp_hashtags.tag = ANY(@tags::text[])
+        AND chirps.created_at >= @since::timestamp
+        AND chirp_visible_to(chirps, sqlc.narg('viewer_id')::uuid, false)
+        AND NOT (@exclude_sensitive::boolean AND chirps.sensitive)
+) AS sample
+INNER JOIN chirps ON chirps.id = sample.chirp_id
+WHERE sample.position <= @chirps_per_tag::bigint
+ORDER BY sample.tag, sample.position;
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- name: ReadTrends :many
+SELECT *
+FROM trends
+WHERE snapshot_id = @snapshot_id
+    AND NOT EXISTS (
+        SELECT 1
+        FROM flagged_hashtags
+        WHERE flagged_hashtags.tag = trends.tag
+    )
+ORDER BY position;
\ No newline at end of file
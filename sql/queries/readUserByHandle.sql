@@ -0,0 +1,4 @@
+-- name: ReadUserByHandle :one
+SELECT *
+FROM users
+WHERE handle = $1;
\ No newline at end of file
@@ -0,0 +1,28 @@
+-- name: ReadUserProfile :one
+SELECT
+    users.id,
+    users.created_at,
+    users.handle,
+    users.display_name,
+    users.bio,
+    users.location,
+    users.website,
+    users.is_chirpy_red,
+    users.protected,
+    users.follower_count,
+    (
+        SELECT COUNT(*)
+        FROM follows
+        WHERE follows.follower_id = users.id
+    ) AS following_count,
+    media_attachments.blob_key AS avatar_blob_key,
+    media_attachments.thumbnail_key AS avatar_thumbnail_key
+FROM users
+LEFT JOIN media_attachments ON media_attachments.id = users.avatar_media_id
+WHERE (users.id = sqlc.narg('id')::uuid OR users.handle = sqlc.narg('handle')::text)
+    AND NOT EXISTS (
+        SELECT 1
+        FROM blocks
+        WHERE (blocks.blocker_id = users.id AND blocks.blocked_id = sqlc.narg('viewer_id')::uuid)
+            OR (blocks.blocker_id = sqlc.narg('viewer_id')::uuid AND blocks.blocked_id = users.id)
+    );
\ No newline at end of file
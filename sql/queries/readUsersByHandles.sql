@@ -0,0 +1,4 @@
+-- name: ReadUsersByHandles :many
+SELECT *
+FROM users
+WHERE handle = ANY(@handles::text[]);
\ No newline at end of file
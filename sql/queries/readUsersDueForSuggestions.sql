@@ -0,0 +1,8 @@
+-- name: ReadUsersDueForSuggestions :many
+SELECT users.id
+FROM users
+LEFT JOIN follow_suggestion_refreshes ON follow_suggestion_refreshes.user_id = users.id
+WHERE follow_suggestion_refreshes.refreshed_at IS NULL
+    OR follow_suggestion_refreshes.refreshed_at < @refreshed_before::timestamp
+ORDER BY follow_suggestion_refreshes.refreshed_at ASC NULLS FIRST, users.id
+LIMIT @row_limit;
\ No newline at end of file
@@ -0,0 +1,15 @@
+-- name: RebuildHomeTimeline :execrows
+INSERT INTO home_timeline_entries (user_id, chirp_id, author_id, created_at)
+SELECT follows.follower_id, recent.id, follows.followee_id, recent.created_at
+FROM follows
+CROSS JOIN LATERAL (
+    SELECT chirps.id, chirps.created_at
+    FROM chirps
+    WHERE chirps.user_id = follows.followee_id
+        AND chirps.published
+        AND chirps.deleted_at IS NULL
+    ORDER BY chirps.created_at DESC, chirps.id DESC
+    LIMIT @per_author_limit
+) recent
+WHERE follows.follower_id = @user_id
+ON CONFLICT (user_id, chirp_id) DO NOTHING;
\ No newline at end of file
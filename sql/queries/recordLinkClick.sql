@@ -0,0 +1,5 @@
+-- name: RecordLinkClick :one
+UPDATE links
+SET clicks = clicks + 1
+WHERE code = $1
+RETURNING url;
\ No newline at end of file
@@ -0,0 +1,24 @@
+-- name: ReplaceFollowSuggestions :exec
+WITH refreshed AS (
+    INSERT INTO follow_suggestion_refreshes (user_id, refreshed_at)
+    VALUES (@user_id::uuid, NOW())
+    ON CONFLICT (user_id) DO UPDATE SET refreshed_at = NOW()
+), removed AS (
+    DELETE FROM follow_suggestions
+    WHERE follow_suggestions.user_id = @user_id::uuid
+        AND NOT (follow_suggestions.suggested_id = ANY(@suggested_ids::uuid[]))
+)
+INSERT INTO follow_suggestions (user_id, suggested_id, score, mutual_count, shared_hashtag_count, recent_chirp_count)
+SELECT @user_id::uuid, suggestion.suggested_id, suggestion.score, suggestion.mutual_count, suggestion.shared_hashtag_count, suggestion.recent_chirp_count
+FROM unnest(
+    @suggested_ids::uuid[],
+    @scores::float8[],
+    @mutual_counts::bigint[],
+    @shared_hashtag_counts::bigint[],
+    @recent_chirp_counts::bigint[]
+) AS suggestion(suggested_id, score, mutual_count, shared_hashtag_count, recent_chirp_count)
+ON CONFLICT (user_id, suggested_id) DO UPDATE SET
+    score = EXCLUDED.score,
+    mutual_count = EXCLUDED.mutual_count,
+    shared_hashtag_count = EXCLUDED.shared_hashtag_count,
+    recent_chirp_count = EXCLUDED.recent_chirp_count;
\ No newline at end of file
@@ -0,0 +1,7 @@
+-- name: RescheduleChirp :one
+UPDATE chirps
+SET publish_at = $3, updated_at = NOW()
+WHERE id = $1
+    AND user_id = $2
+    AND NOT published
+RETURNING *;
\ No newline at end of file
@@ -0,0 +1,15 @@
+-- name: RestoreChirp :one
+WITH restored AS (
+    UPDATE chirps
+    SET deleted_at = NULL, deletion_reason = NULL, updated_at = NOW()
+    WHERE id = $1
+        AND deleted_at > @deleted_after
+    RETURNING *
+), replied AS (
+    UPDATE chirps
+    SET reply_count = reply_count + 1
+    FROM restored
+    WHERE chirps.id = restored.reply_to_id
+)
+SELECT *
+FROM restored;
\ No newline at end of file
@@ -0,0 +1,36 @@
+-- name: SearchChirps :many
+SELECT
+    ranked.*,
+    ts_headline(
+        'english',
+        -- Escape the body so the <mark> tags are the only markup in the
+        -- snippet and clients can render it as HTML.
+        replace(replace(replace(replace(replace(ranked.body, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;'),
+        websearch_to_tsquery('english', @query::text),
+        'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, HighlightAll=false'
+    )::text AS snippet
+FROM (
+    SELECT
+        chirps.*,
+        ts_rank(to_tsvector('english', chirps.body), websearch_to_tsquery('english', @query::text))::real AS rank
+    FROM chirps
+    WHERE chirp_visible_to(chirps, sqlc.narg('viewer_id')::uuid, false)
+        AND (@query::text = '' OR to_tsvector('english', chirps.body) @@ websearch_to_tsquery('english', @query::text))
+        AND (sqlc.narg('author_id')::uuid IS NULL OR chirps.user_id = sqlc.narg('author_id')::uuid)
+        AND (sqlc.narg('since')::timestamp IS NULL OR chirps.created_at >= sqlc.narg('since')::timestamp)
+        AND (sqlc.narg('until')::timestamp IS NULL OR chirps.created_at < sqlc.narg('until')::timestamp)
+        AND NOT EXISTS (
+            SELECT 1
+            FROM unnest(@hashtags::text[]) AS wanted(tag)
+            WHERE NOT EXISTS (
+                SELECT 1
+                FROM chirp_hashtags
+                WHERE chirp_hashtags.chirp_id = chirps.id
+                    AND chirp_hashtags.tag = wanted.tag
+            )
+        )
+) AS ranked
+WHERE sqlc.narg('cursor_rank')::real IS NULL
+    OR (ranked.rank, ranked.id) < (sqlc.narg('cursor_rank')::real, sqlc.narg('cursor_id')::uuid)
+ORDER BY ranked.rank DESC, ranked.id DESC
+LIMIT @row_limit;
\ No newline at end of file
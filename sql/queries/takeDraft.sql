@@ -0,0 +1,5 @@
+-- name: TakeDraft :one
+DELETE FROM drafts
+WHERE id = $1
+    AND user_id = $2
+RETURNING *;
\ No newline at end of file
@@ -0,0 +1,4 @@
+-- name: TakeFollowRequests :many
+DELETE FROM follow_requests
+WHERE target_id = $1
+RETURNING requester_id;
\ No newline at end of file
@@ -0,0 +1,4 @@
+-- name: TouchConversation :exec
+UPDATE conversations
+SET updated_at = @updated_at
+WHERE id = @id;
\ No newline at end of file
@@ -0,0 +1,3 @@
+-- name: UnflagHashtag :execrows
+DELETE FROM flagged_hashtags
+WHERE tag = @tag;
\ No newline at end of file
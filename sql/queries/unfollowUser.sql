@@ -0,0 +1,10 @@
+-- name: UnfollowUser :execrows
+WITH unfollowed AS (
+    DELETE FROM follows
+    WHERE follower_id = $1 AND followee_id = $2
+    RETURNING followee_id
+)
+UPDATE users
+SET follower_count = follower_count - 1
+FROM unfollowed
+WHERE users.id = unfollowed.followee_id;
\ No newline at end of file
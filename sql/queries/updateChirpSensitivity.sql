@@ -0,0 +1,5 @@
+-- name: UpdateChirpSensitivity :one
+UPDATE chirps
+SET content_warning = $2, sensitive = $3, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- name: UpdateConversation :one
+UPDATE conversations
+SET filter_profanity = @filter_profanity
+WHERE conversations.id = @id
+    AND EXISTS (
+        SELECT 1
+        FROM conversation_participants
+        WHERE conversation_participants.conversation_id = conversations.id
+            AND conversation_participants.user_id = @user_id
+    )
+RETURNING *;
\ No newline at end of file
@@ -0,0 +1,6 @@
+-- name: UpdateDraft :one
+UPDATE drafts
+SET body = $3, visibility = $4, content_warning = $5, sensitive = $6, updated_at = NOW()
+WHERE id = $1
+    AND user_id = $2
+RETURNING *;
\ No newline at end of file
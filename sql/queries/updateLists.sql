@@ -0,0 +1,6 @@
+-- name: UpdateList :one
+UPDATE lists
+SET name = $3, description = $4, visibility = $5, updated_at = NOW()
+WHERE id = $1
+    AND user_id = $2
+RETURNING *;
\ No newline at end of file
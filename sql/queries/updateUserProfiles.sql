@@ -0,0 +1,5 @@
+-- name: UpdateUserProfile :one
+UPDATE users
+SET display_name = $2, bio = $3, location = $4, website = $5, avatar_media_id = $6, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
\ No newline at end of file
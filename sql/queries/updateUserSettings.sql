@@ -0,0 +1,5 @@
+-- name: UpdateUserSettings :one
+UPDATE users
+SET sensitive_content = COALESCE(sqlc.narg('sensitive_content'), sensitive_content), protected = COALESCE(sqlc.narg('protected'), protected), updated_at = NOW()
+WHERE id = @id
+RETURNING *;
\ No newline at end of file
@@ -1,5 +1,5 @@
 -- name: UpdateUser :one
 UPDATE users
-SET email = $2, hashed_password = $3, updated_at = NOW()
+SET email = $2, hashed_password = $3, handle = COALESCE(sqlc.narg('handle'), handle), updated_at = NOW()
 WHERE id = $1
-RETURNING *;
+RETURNING *;
\ No newline at end of file
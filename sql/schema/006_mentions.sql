@@ -0,0 +1,20 @@
+-- +goose Up
+ALTER TABLE users
+ADD COLUMN handle TEXT UNIQUE;
+
+CREATE TABLE chirp_mentions(
+    chirp_id UUID NOT NULL REFERENCES chirps(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    handle TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    notified_at TIMESTAMP,
+    PRIMARY KEY (chirp_id, user_id)
+);
+
+CREATE INDEX chirp_mentions_user_id_idx ON chirp_mentions (user_id, created_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS chirp_mentions;
+
+ALTER TABLE users
+DROP COLUMN handle;
@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE INDEX chirps_body_search_idx ON chirps USING GIN (to_tsvector('english', body));
+
+CREATE TABLE chirp_hashtags(
+    chirp_id UUID NOT NULL REFERENCES chirps(id) ON DELETE CASCADE,
+    tag TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (chirp_id, tag)
+);
+
+CREATE INDEX chirp_hashtags_tag_idx ON chirp_hashtags (tag, created_at DESC);
+
+INSERT INTO chirp_hashtags (chirp_id, tag, created_at)
+SELECT DISTINCT chirps.id, lower(match[1]), chirps.created_at
+FROM chirps, regexp_matches(chirps.body, '(?:^|[^A-Za-z0-9_&])#([A-Za-z0-9_]{1,50})(?![A-Za-z0-9_])', 'g') AS match
+WHERE match[1] !~ '^[0-9]+$';
+
+-- +goose Down
+DROP TABLE IF EXISTS chirp_hashtags;
+
+DROP INDEX IF EXISTS chirps_body_search_idx;
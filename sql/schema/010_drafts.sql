@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE drafts(
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    body TEXT NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX drafts_user_id_idx ON drafts (user_id, updated_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS drafts;
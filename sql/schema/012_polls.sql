@@ -0,0 +1,33 @@
+-- +goose Up
+CREATE TABLE polls(
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL,
+    chirp_id UUID NOT NULL UNIQUE REFERENCES chirps(id) ON DELETE CASCADE,
+    closes_at TIMESTAMP NOT NULL,
+    results_visibility TEXT NOT NULL DEFAULT 'always'
+        CHECK (results_visibility IN ('always', 'after_vote', 'after_close'))
+);
+
+CREATE TABLE poll_options(
+    id UUID PRIMARY KEY,
+    poll_id UUID NOT NULL REFERENCES polls(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    label TEXT NOT NULL,
+    UNIQUE (poll_id, position)
+);
+
+CREATE TABLE poll_votes(
+    poll_id UUID NOT NULL REFERENCES polls(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    option_id UUID NOT NULL REFERENCES poll_options(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (poll_id, user_id)
+);
+
+CREATE INDEX poll_votes_option_id_idx ON poll_votes (option_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS poll_votes;
+DROP TABLE IF EXISTS poll_options;
+DROP TABLE IF EXISTS polls;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- Bookmarks of a soft-deleted chirp stay but are hidden by the read queries,
+-- so restoring the chirp brings them back. They go away through the cascade
+-- when the chirp is purged.
+CREATE TABLE bookmarks(
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    chirp_id UUID NOT NULL REFERENCES chirps(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, chirp_id)
+);
+
+CREATE INDEX bookmarks_user_id_created_at_idx ON bookmarks (user_id, created_at DESC, chirp_id DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS bookmarks;
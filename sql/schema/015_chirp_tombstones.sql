@@ -0,0 +1,12 @@
+-- +goose Up
+ALTER TABLE chirps
+ADD COLUMN deleted_at TIMESTAMP,
+ADD COLUMN deletion_reason TEXT CHECK (deletion_reason IN ('owner', 'moderator'));
+
+CREATE INDEX chirps_deleted_at_idx ON chirps (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS chirps_deleted_at_idx;
+ALTER TABLE chirps
+DROP COLUMN deletion_reason,
+DROP COLUMN deleted_at;
@@ -0,0 +1,42 @@
+-- +goose Up
+ALTER TABLE chirps
+ADD COLUMN visibility TEXT NOT NULL DEFAULT 'public'
+    CHECK (visibility IN ('public', 'followers', 'unlisted'));
+
+-- Every query that returns chirps to a user filters through chirp_visible_to,
+-- so the visibility rules live in exactly one place. direct is true when the
+-- chirp was asked for by ID rather than found through a listing or search.
+
+-- +goose StatementBegin
+CREATE FUNCTION chirp_audience_includes(chirp chirps, viewer_id UUID, direct BOOLEAN)
+RETURNS BOOLEAN
+LANGUAGE sql
+STABLE
+AS $$
+    SELECT CASE chirp.visibility
+        WHEN 'public' THEN true
+        WHEN 'unlisted' THEN direct OR (viewer_id IS NOT NULL AND chirp.user_id = viewer_id)
+        -- Only the author until there is a follow graph to consult.
+        WHEN 'followers' THEN viewer_id IS NOT NULL AND chirp.user_id = viewer_id
+        ELSE false
+    END
+$$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE FUNCTION chirp_visible_to(chirp chirps, viewer_id UUID, direct BOOLEAN)
+RETURNS BOOLEAN
+LANGUAGE sql
+STABLE
+AS $$
+    SELECT chirp.published
+        AND chirp.deleted_at IS NULL
+        AND chirp_audience_includes(chirp, viewer_id, direct)
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP FUNCTION IF EXISTS chirp_visible_to(chirps, UUID, BOOLEAN);
+DROP FUNCTION IF EXISTS chirp_audience_includes(chirps, UUID, BOOLEAN);
+ALTER TABLE chirps
+DROP COLUMN visibility;
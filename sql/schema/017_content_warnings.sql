@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE chirps
+ADD COLUMN content_warning TEXT,
+ADD COLUMN sensitive BOOLEAN NOT NULL DEFAULT false;
+
+-- How a user wants sensitive chirps and chirps with a content warning
+-- presented: expanded, collapsed behind the warning, or left out of listings.
+ALTER TABLE users
+ADD COLUMN sensitive_content TEXT NOT NULL DEFAULT 'collapse'
+    CHECK (sensitive_content IN ('expand', 'collapse', 'hide'));
+
+-- +goose Down
+ALTER TABLE users
+DROP COLUMN sensitive_content;
+ALTER TABLE chirps
+DROP COLUMN sensitive,
+DROP COLUMN content_warning;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- Each distinct URL gets one short code, shared by every chirp that links to
+-- it, so clicks are counted per destination.
+CREATE TABLE links(
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL,
+    code TEXT NOT NULL UNIQUE,
+    url TEXT NOT NULL UNIQUE,
+    clicks BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE TABLE chirp_links(
+    chirp_id UUID NOT NULL REFERENCES chirps(id) ON DELETE CASCADE,
+    link_id UUID NOT NULL REFERENCES links(id) ON DELETE CASCADE,
+    PRIMARY KEY (chirp_id, link_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS chirp_links;
+DROP TABLE IF EXISTS links;
@@ -0,0 +1,55 @@
+-- +goose Up
+CREATE TABLE follows(
+    follower_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    followee_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (follower_id, followee_id),
+    CHECK (follower_id <> followee_id)
+);
+
+-- Follower and following lists page newest first.
+CREATE INDEX follows_follower_id_created_at_idx ON follows (follower_id, created_at DESC, followee_id DESC);
+CREATE INDEX follows_followee_id_created_at_idx ON follows (followee_id, created_at DESC, follower_id DESC);
+
+-- Followers-only chirps are now shown to the author's followers.
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION chirp_audience_includes(chirp chirps, viewer_id UUID, direct BOOLEAN)
+RETURNS BOOLEAN
+LANGUAGE sql
+STABLE
+AS $$
+    SELECT CASE chirp.visibility
+        WHEN 'public' THEN true
+        WHEN 'unlisted' THEN direct OR (viewer_id IS NOT NULL AND chirp.user_id = viewer_id)
+        WHEN 'followers' THEN viewer_id IS NOT NULL AND (
+            chirp.user_id = viewer_id
+            OR EXISTS (
+                SELECT 1
+                FROM follows
+                WHERE follows.follower_id = viewer_id
+                    AND follows.followee_id = chirp.user_id
+            )
+        )
+        ELSE false
+    END
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION chirp_audience_includes(chirp chirps, viewer_id UUID, direct BOOLEAN)
+RETURNS BOOLEAN
+LANGUAGE sql
+STABLE
+AS $$
+    SELECT CASE chirp.visibility
+        WHEN 'public' THEN true
+        WHEN 'unlisted' THEN direct OR (viewer_id IS NOT NULL AND chirp.user_id = viewer_id)
+        WHEN 'followers' THEN viewer_id IS NOT NULL AND chirp.user_id = viewer_id
+        ELSE false
+    END
+$$;
+-- +goose StatementEnd
+
+DROP TABLE IF EXISTS follows;
@@ -0,0 +1,41 @@
+-- +goose Up
+ALTER TABLE users
+ADD COLUMN follower_count BIGINT NOT NULL DEFAULT 0;
+
+UPDATE users
+SET follower_count = (SELECT COUNT(*) FROM follows WHERE follows.followee_id = users.id);
+
+-- Materialized home timelines. The fan-out worker copies each new chirp into
+-- the timelines of its author's followers, except for authors with more
+-- followers than the fan-out limit, whose chirps are merged in when the
+-- timeline is read. Entries only point at chirps, so visibility and deletion
+-- are still checked on every read.
+CREATE TABLE home_timeline_entries(
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    chirp_id UUID NOT NULL REFERENCES chirps(id) ON DELETE CASCADE,
+    author_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, chirp_id)
+);
+
+CREATE INDEX home_timeline_entries_user_id_created_at_idx ON home_timeline_entries (user_id, created_at DESC, chirp_id DESC);
+CREATE INDEX home_timeline_entries_user_id_author_id_idx ON home_timeline_entries (user_id, author_id);
+
+-- Published chirps waiting to be fanned out.
+CREATE TABLE timeline_fanouts(
+    chirp_id UUID PRIMARY KEY REFERENCES chirps(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL
+);
+
+INSERT INTO home_timeline_entries (user_id, chirp_id, author_id, created_at)
+SELECT follows.follower_id, chirps.id, follows.followee_id, chirps.created_at
+FROM follows
+INNER JOIN chirps ON chirps.user_id = follows.followee_id
+WHERE chirps.published;
+
+-- +goose Down
+DROP TABLE IF EXISTS timeline_fanouts;
+DROP TABLE IF EXISTS home_timeline_entries;
+
+ALTER TABLE users
+DROP COLUMN follower_count;
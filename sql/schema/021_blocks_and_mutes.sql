@@ -0,0 +1,140 @@
+-- +goose Up
+CREATE TABLE blocks(
+    blocker_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    blocked_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (blocker_id, blocked_id),
+    CHECK (blocker_id <> blocked_id)
+);
+
+CREATE INDEX blocks_blocked_id_idx ON blocks (blocked_id, blocker_id);
+
+CREATE TABLE mutes(
+    muter_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    muted_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (muter_id, muted_id),
+    CHECK (muter_id <> muted_id)
+);
+
+-- Keywords are stored lowercased and match anywhere in a chirp body.
+CREATE TABLE muted_keywords(
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    keyword TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP,
+    UNIQUE (user_id, keyword)
+);
+
+-- A block hides each user's chirps from the other everywhere, even when
+-- asked for by ID. Mutes only apply to the muter's listings, searches and
+-- timelines; a muted chirp can still be opened directly.
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION chirp_audience_includes(chirp chirps, viewer_id UUID, direct BOOLEAN)
+RETURNS BOOLEAN
+LANGUAGE sql
+STABLE
+AS $$
+    SELECT NOT EXISTS (
+            SELECT 1
+            FROM blocks
+            WHERE (blocks.blocker_id = viewer_id AND blocks.blocked_id = chirp.user_id)
+                OR (blocks.blocker_id = chirp.user_id AND blocks.blocked_id = viewer_id)
+        )
+        AND CASE chirp.visibility
+            WHEN 'public' THEN true
+            WHEN 'unlisted' THEN direct OR (viewer_id IS NOT NULL AND chirp.user_id = viewer_id)
+            WHEN 'followers' THEN viewer_id IS NOT NULL AND (
+                chirp.user_id = viewer_id
+                OR EXISTS (
+                    SELECT 1
+                    FROM follows
+                    WHERE follows.follower_id = viewer_id
+                        AND follows.followee_id = chirp.user_id
+                )
+            )
+            ELSE false
+        END
+$$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE FUNCTION chirp_muted_by(chirp chirps, viewer_id UUID)
+RETURNS BOOLEAN
+LANGUAGE sql
+STABLE
+AS $$
+    SELECT chirp.user_id IS DISTINCT FROM viewer_id
+        AND (
+            EXISTS (
+                SELECT 1
+                FROM mutes
+                WHERE mutes.muter_id = viewer_id
+                    AND mutes.muted_id = chirp.user_id
+            )
+            OR EXISTS (
+                SELECT 1
+                FROM muted_keywords
+                WHERE muted_keywords.user_id = viewer_id
+                    AND (muted_keywords.expires_at IS NULL OR muted_keywords.expires_at > NOW())
+                    AND strpos(lower(chirp.body), muted_keywords.keyword) > 0
+            )
+        )
+$$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION chirp_visible_to(chirp chirps, viewer_id UUID, direct BOOLEAN)
+RETURNS BOOLEAN
+LANGUAGE sql
+STABLE
+AS $$
+    SELECT chirp.published
+        AND chirp.deleted_at IS NULL
+        AND chirp_audience_includes(chirp, viewer_id, direct)
+        AND (direct OR viewer_id IS NULL OR NOT chirp_muted_by(chirp, viewer_id))
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION chirp_visible_to(chirp chirps, viewer_id UUID, direct BOOLEAN)
+RETURNS BOOLEAN
+LANGUAGE sql
+STABLE
+AS $$
+    SELECT chirp.published
+        AND chirp.deleted_at IS NULL
+        AND chirp_audience_includes(chirp, viewer_id, direct)
+$$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION chirp_audience_includes(chirp chirps, viewer_id UUID, direct BOOLEAN)
+RETURNS BOOLEAN
+LANGUAGE sql
+STABLE
+AS $$
+    SELECT CASE chirp.visibility
+        WHEN 'public' THEN true
+        WHEN 'unlisted' THEN direct OR (viewer_id IS NOT NULL AND chirp.user_id = viewer_id)
+        WHEN 'followers' THEN viewer_id IS NOT NULL AND (
+            chirp.user_id = viewer_id
+            OR EXISTS (
+                SELECT 1
+                FROM follows
+                WHERE follows.follower_id = viewer_id
+                    AND follows.followee_id = chirp.user_id
+            )
+        )
+        ELSE false
+    END
+$$;
+-- +goose StatementEnd
+
+DROP FUNCTION IF EXISTS chirp_muted_by(chirps, UUID);
+DROP TABLE IF EXISTS muted_keywords;
+DROP TABLE IF EXISTS mutes;
+DROP TABLE IF EXISTS blocks;
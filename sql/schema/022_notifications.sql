@@ -0,0 +1,36 @@
+-- +goose Up
+-- Notifications are grouped: while a notification is unread, further events
+-- of the same type about the same chirp add their actor to it instead of
+-- creating a new one, so a user sees "alice and 4 others followed you".
+CREATE TABLE notifications(
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type TEXT NOT NULL CHECK (type IN ('follow', 'mention')),
+    chirp_id UUID REFERENCES chirps(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    read_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX notifications_unread_group_idx ON notifications (user_id, type, COALESCE(chirp_id, '00000000-0000-0000-0000-000000000000'::uuid))
+    WHERE read_at IS NULL;
+CREATE INDEX notifications_user_id_updated_at_idx ON notifications (user_id, updated_at DESC, id DESC);
+
+CREATE TABLE notification_actors(
+    notification_id UUID NOT NULL REFERENCES notifications(id) ON DELETE CASCADE,
+    actor_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (notification_id, actor_id)
+);
+
+-- Mentions made before notifications existed are not announced.
+UPDATE chirp_mentions
+SET notified_at = NOW()
+FROM chirps
+WHERE chirps.id = chirp_mentions.chirp_id
+    AND chirps.published
+    AND chirp_mentions.notified_at IS NULL;
+
+-- +goose Down
+DROP TABLE IF EXISTS notification_actors;
+DROP TABLE IF EXISTS notifications;
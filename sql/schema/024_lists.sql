@@ -0,0 +1,28 @@
+-- +goose Up
+-- Lists are curated sets of accounts whose chirps make up a timeline.
+-- Private lists are only visible to their owner; members are not told they
+-- were added to either kind.
+CREATE TABLE lists(
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    visibility TEXT NOT NULL DEFAULT 'public' CHECK (visibility IN ('public', 'private'))
+);
+
+CREATE INDEX lists_user_id_idx ON lists (user_id, created_at DESC);
+
+CREATE TABLE list_members(
+    list_id UUID NOT NULL REFERENCES lists(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (list_id, user_id)
+);
+
+CREATE INDEX list_members_user_id_idx ON list_members (user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS list_members;
+DROP TABLE IF EXISTS lists;
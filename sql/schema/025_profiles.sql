@@ -0,0 +1,17 @@
+-- +goose Up
+-- Profile fields are public. An avatar is an uploaded image that isn't
+-- attached to any chirp; deleting the image clears the avatar.
+ALTER TABLE users
+ADD COLUMN display_name TEXT NOT NULL DEFAULT '',
+ADD COLUMN bio TEXT NOT NULL DEFAULT '',
+ADD COLUMN location TEXT NOT NULL DEFAULT '',
+ADD COLUMN website TEXT NOT NULL DEFAULT '',
+ADD COLUMN avatar_media_id UUID REFERENCES media_attachments(id) ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE users
+DROP COLUMN avatar_media_id,
+DROP COLUMN website,
+DROP COLUMN location,
+DROP COLUMN bio,
+DROP COLUMN display_name;
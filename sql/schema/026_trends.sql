@@ -0,0 +1,34 @@
+-- +goose Up
+-- Moderators can flag a hashtag to keep it out of trends. Chirps using the
+-- tag are left alone.
+CREATE TABLE flagged_hashtags(
+    tag TEXT PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL,
+    reason TEXT NOT NULL DEFAULT ''
+);
+
+-- Each run of the trends job stores a snapshot so trends are served without
+-- recounting hashtags on every request.
+CREATE TABLE trend_snapshots(
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL,
+    window_start TIMESTAMP NOT NULL
+);
+
+CREATE INDEX trend_snapshots_created_at_idx ON trend_snapshots (created_at DESC);
+
+CREATE TABLE trends(
+    snapshot_id UUID NOT NULL REFERENCES trend_snapshots(id) ON DELETE CASCADE,
+    tag TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    score DOUBLE PRECISION NOT NULL,
+    chirp_count BIGINT NOT NULL,
+    author_count BIGINT NOT NULL,
+    baseline_count BIGINT NOT NULL,
+    PRIMARY KEY (snapshot_id, tag)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS trends;
+DROP TABLE IF EXISTS trend_snapshots;
+DROP TABLE IF EXISTS flagged_hashtags;
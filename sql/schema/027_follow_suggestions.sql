@@ -0,0 +1,28 @@
+-- +goose Up
+-- Follow suggestions are computed in batches by a background job and read
+-- back as is. Rows are only replaced on the next refresh, so accounts the
+-- user has since followed, blocked or muted are filtered out when reading.
+CREATE TABLE follow_suggestions(
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    suggested_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    score DOUBLE PRECISION NOT NULL,
+    mutual_count BIGINT NOT NULL,
+    shared_hashtag_count BIGINT NOT NULL,
+    recent_chirp_count BIGINT NOT NULL,
+    PRIMARY KEY (user_id, suggested_id)
+);
+
+CREATE INDEX follow_suggestions_score_idx ON follow_suggestions (user_id, score DESC);
+
+CREATE INDEX follow_suggestions_suggested_id_idx ON follow_suggestions (suggested_id);
+
+-- When each user's suggestions were last computed, including users who
+-- ended up with none.
+CREATE TABLE follow_suggestion_refreshes(
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    refreshed_at TIMESTAMP NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS follow_suggestion_refreshes;
+DROP TABLE IF EXISTS follow_suggestions;
@@ -0,0 +1,100 @@
+-- +goose Up
+-- Protected accounts approve each follower. Their chirps, whatever their
+-- visibility, are only shown to the author and approved followers; anyone
+-- already following when an account becomes protected stays approved.
+ALTER TABLE users ADD COLUMN protected BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE follow_requests(
+    requester_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    target_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (requester_id, target_id)
+);
+
+CREATE INDEX follow_requests_target_id_idx ON follow_requests (target_id, created_at DESC, requester_id DESC);
+
+ALTER TABLE notifications DROP CONSTRAINT notifications_type_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_type_check
+    CHECK (type IN ('follow', 'mention', 'follow_request', 'follow_approved'));
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION chirp_audience_includes(chirp chirps, viewer_id UUID, direct BOOLEAN)
+RETURNS BOOLEAN
+LANGUAGE sql
+STABLE
+AS $$
+    SELECT NOT EXISTS (
+            SELECT 1
+            FROM blocks
+            WHERE (blocks.blocker_id = viewer_id AND blocks.blocked_id = chirp.user_id)
+                OR (blocks.blocker_id = chirp.user_id AND blocks.blocked_id = viewer_id)
+        )
+        AND (
+            NOT EXISTS (
+                SELECT 1
+                FROM users
+                WHERE users.id = chirp.user_id
+                    AND users.protected
+            )
+            OR (viewer_id IS NOT NULL AND chirp.user_id = viewer_id)
+            OR EXISTS (
+                SELECT 1
+                FROM follows
+                WHERE follows.follower_id = viewer_id
+                    AND follows.followee_id = chirp.user_id
+            )
+        )
+        AND CASE chirp.visibility
+            WHEN 'public' THEN true
+            WHEN 'unlisted' THEN direct OR (viewer_id IS NOT NULL AND chirp.user_id = viewer_id)
+            WHEN 'followers' THEN viewer_id IS NOT NULL AND (
+                chirp.user_id = viewer_id
+                OR EXISTS (
+                    SELECT 1
+                    FROM follows
+                    WHERE follows.follower_id = viewer_id
+                        AND follows.followee_id = chirp.user_id
+                )
+            )
+            ELSE false
+        END
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION chirp_audience_includes(chirp chirps, viewer_id UUID, direct BOOLEAN)
+RETURNS BOOLEAN
+LANGUAGE sql
+STABLE
+AS $$
+    SELECT NOT EXISTS (
+            SELECT 1
+            FROM blocks
+            WHERE (blocks.blocker_id = viewer_id AND blocks.blocked_id = chirp.user_id)
+                OR (blocks.blocker_id = chirp.user_id AND blocks.blocked_id = viewer_id)
+        )
+        AND CASE chirp.visibility
+            WHEN 'public' THEN true
+            WHEN 'unlisted' THEN direct OR (viewer_id IS NOT NULL AND chirp.user_id = viewer_id)
+            WHEN 'followers' THEN viewer_id IS NOT NULL AND (
+                chirp.user_id = viewer_id
+                OR EXISTS (
+                    SELECT 1
+                    FROM follows
+                    WHERE follows.follower_id = viewer_id
+                        AND follows.followee_id = chirp.user_id
+                )
+            )
+            ELSE false
+        END
+$$;
+-- +goose StatementEnd
+
+DELETE FROM notifications WHERE type IN ('follow_request', 'follow_approved');
+ALTER TABLE notifications DROP CONSTRAINT notifications_type_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_type_check
+    CHECK (type IN ('follow', 'mention'));
+
+DROP TABLE IF EXISTS follow_requests;
+ALTER TABLE users DROP COLUMN IF EXISTS protected;
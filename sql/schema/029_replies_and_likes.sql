@@ -0,0 +1,31 @@
+-- +goose Up
+-- reply_count and like_count are kept up to date by the queries that change
+-- them, like users.follower_count, so listings can filter and sort on
+-- engagement without counting rows. reply_count only includes replies that
+-- are published and not deleted. A reply whose parent is purged becomes a
+-- standalone chirp.
+ALTER TABLE chirps
+ADD COLUMN reply_to_id UUID REFERENCES chirps(id) ON DELETE SET NULL,
+ADD COLUMN reply_count INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN like_count INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX chirps_reply_to_id_idx ON chirps (reply_to_id, created_at, id) WHERE reply_to_id IS NOT NULL;
+CREATE INDEX chirps_engagement_idx ON chirps ((like_count + reply_count), id);
+
+CREATE TABLE likes(
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    chirp_id UUID NOT NULL REFERENCES chirps(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, chirp_id)
+);
+
+CREATE INDEX likes_chirp_id_idx ON likes (chirp_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS likes;
+DROP INDEX IF EXISTS chirps_engagement_idx;
+DROP INDEX IF EXISTS chirps_reply_to_id_idx;
+ALTER TABLE chirps
+DROP COLUMN IF EXISTS like_count,
+DROP COLUMN IF EXISTS reply_count,
+DROP COLUMN IF EXISTS reply_to_id;
@@ -0,0 +1,26 @@
+-- +goose Up
+-- Replies are announced by the notification job once they are published,
+-- like mentions; reply_notified_at records that a reply has been handled.
+-- Replies published before this are not announced.
+ALTER TABLE chirps ADD COLUMN reply_notified_at TIMESTAMP;
+
+UPDATE chirps
+SET reply_notified_at = NOW()
+WHERE reply_to_id IS NOT NULL
+    AND published;
+
+CREATE INDEX chirps_reply_unnotified_idx ON chirps (created_at)
+    WHERE reply_to_id IS NOT NULL AND reply_notified_at IS NULL;
+
+ALTER TABLE notifications DROP CONSTRAINT notifications_type_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_type_check
+    CHECK (type IN ('follow', 'mention', 'follow_request', 'follow_approved', 'reply', 'like'));
+
+-- +goose Down
+DELETE FROM notifications WHERE type IN ('reply', 'like');
+ALTER TABLE notifications DROP CONSTRAINT notifications_type_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_type_check
+    CHECK (type IN ('follow', 'mention', 'follow_request', 'follow_approved'));
+
+DROP INDEX IF EXISTS chirps_reply_unnotified_idx;
+ALTER TABLE chirps DROP COLUMN IF EXISTS reply_notified_at;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- Served media is looked up by key to decide who may see it.
+CREATE INDEX media_attachments_blob_key_idx ON media_attachments (blob_key);
+CREATE INDEX media_attachments_thumbnail_key_idx ON media_attachments (thumbnail_key);
+
+-- +goose Down
+DROP INDEX IF EXISTS media_attachments_thumbnail_key_idx;
+DROP INDEX IF EXISTS media_attachments_blob_key_idx;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- Queries that remove media rows queue their blobs here, and a background
+-- job deletes them from the blob store. A failed delete is retried on the
+-- next run instead of leaving the file behind for good.
+CREATE TABLE blob_deletions(
+    blob_key TEXT PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS blob_deletions;
@@ -0,0 +1,32 @@
+-- +goose Up
+-- These columns hold points in time that are compared with NOW() or with
+-- times computed in Go. As TIMESTAMP they were only right while the session
+-- time zone was UTC. publish_at, closes_at and expires_at were written from
+-- Go in UTC; deleted_at and the upload time were written by NOW() in the
+-- session time zone, which the plain cast assumes.
+ALTER TABLE chirps
+ALTER COLUMN publish_at TYPE TIMESTAMPTZ USING publish_at AT TIME ZONE 'UTC',
+ALTER COLUMN deleted_at TYPE TIMESTAMPTZ;
+
+ALTER TABLE polls
+ALTER COLUMN closes_at TYPE TIMESTAMPTZ USING closes_at AT TIME ZONE 'UTC';
+
+ALTER TABLE muted_keywords
+ALTER COLUMN expires_at TYPE TIMESTAMPTZ USING expires_at AT TIME ZONE 'UTC';
+
+ALTER TABLE media_attachments
+ALTER COLUMN created_at TYPE TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE media_attachments
+ALTER COLUMN created_at TYPE TIMESTAMP;
+
+ALTER TABLE muted_keywords
+ALTER COLUMN expires_at TYPE TIMESTAMP USING expires_at AT TIME ZONE 'UTC';
+
+ALTER TABLE polls
+ALTER COLUMN closes_at TYPE TIMESTAMP USING closes_at AT TIME ZONE 'UTC';
+
+ALTER TABLE chirps
+ALTER COLUMN publish_at TYPE TIMESTAMP USING publish_at AT TIME ZONE 'UTC',
+ALTER COLUMN deleted_at TYPE TIMESTAMP;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- Drafts keep the settings the chirp will be published with.
+ALTER TABLE drafts
+ADD COLUMN visibility TEXT NOT NULL DEFAULT 'public'
+    CHECK (visibility IN ('public', 'followers', 'unlisted')),
+ADD COLUMN content_warning TEXT,
+ADD COLUMN sensitive BOOLEAN NOT NULL DEFAULT false;
+
+-- +goose Down
+ALTER TABLE drafts
+DROP COLUMN IF EXISTS sensitive,
+DROP COLUMN IF EXISTS content_warning,
+DROP COLUMN IF EXISTS visibility;
@@ -0,0 +1,36 @@
+-- +goose Up
+-- A one-to-one conversation records its two participants so a unique index
+-- can guarantee there is only one per pair, whoever started it. Group
+-- conversations leave both columns NULL.
+ALTER TABLE conversations
+ADD COLUMN direct_user_a UUID REFERENCES users(id) ON DELETE SET NULL,
+ADD COLUMN direct_user_b UUID REFERENCES users(id) ON DELETE SET NULL;
+
+-- Where duplicates already exist, the oldest conversation stays the pair's
+-- direct conversation, as ReadDirectConversation used to pick it.
+UPDATE conversations
+SET direct_user_a = pairs.user_a, direct_user_b = pairs.user_b
+FROM (
+    SELECT DISTINCT ON (participants.user_a, participants.user_b) participants.conversation_id, participants.user_a, participants.user_b
+    FROM (
+        SELECT
+            conversation_id,
+            (array_agg(user_id ORDER BY user_id))[1] AS user_a,
+            (array_agg(user_id ORDER BY user_id))[2] AS user_b
+        FROM conversation_participants
+        GROUP BY conversation_id
+        HAVING COUNT(*) = 2
+    ) AS participants
+    INNER JOIN conversations ON conversations.id = participants.conversation_id
+    ORDER BY participants.user_a, participants.user_b, conversations.created_at ASC
+) AS pairs
+WHERE conversations.id = pairs.conversation_id;
+
+CREATE UNIQUE INDEX conversations_direct_pair_idx ON conversations (LEAST(direct_user_a, direct_user_b), GREATEST(direct_user_a, direct_user_b))
+    WHERE direct_user_a IS NOT NULL AND direct_user_b IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS conversations_direct_pair_idx;
+ALTER TABLE conversations
+DROP COLUMN IF EXISTS direct_user_b,
+DROP COLUMN IF EXISTS direct_user_a;
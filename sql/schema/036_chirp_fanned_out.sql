@@ -0,0 +1,22 @@
+-- +goose Up
+-- Whether a chirp is delivered through materialized home timelines is
+-- decided once, when it is queued for fan-out, from its author's follower
+-- count at that moment. Reading the flag instead of the current count keeps
+-- chirps from going missing, or being served twice, when an author crosses
+-- the fan-out limit later.
+ALTER TABLE chirps ADD COLUMN fanned_out BOOLEAN NOT NULL DEFAULT false;
+
+-- Older chirps count as fanned out where timelines already hold them.
+UPDATE chirps
+SET fanned_out = true
+WHERE EXISTS (
+    SELECT 1
+    FROM home_timeline_entries
+    WHERE home_timeline_entries.chirp_id = chirps.id
+);
+
+CREATE INDEX chirps_fan_in_idx ON chirps (user_id, created_at DESC, id DESC) WHERE NOT fanned_out;
+
+-- +goose Down
+DROP INDEX IF EXISTS chirps_fan_in_idx;
+ALTER TABLE chirps DROP COLUMN IF EXISTS fanned_out;